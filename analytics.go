@@ -0,0 +1,161 @@
+package bitstamp
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInsufficientLiquidity = errors.New("not enough liquidity to fill size")
+
+// analyticsDecimals number of decimal places used for calculated prices and amounts
+const analyticsDecimals = 8
+
+// SizeUnit defines the currency a size is quoted in
+type SizeUnit int
+
+const (
+	// BaseSize size is an amount in base currency (Example: 0.5 BTC for BTC/USD)
+	BaseSize SizeUnit = iota
+	// CounterSize size is an amount in counter currency (Example: 1000 USD for BTC/USD), this is how
+	// CreateBuyInstantOrderRequest amount is quoted
+	CounterSize
+)
+
+// FillEstimate result of walking an order book side to fill a size
+type FillEstimate struct {
+	// BaseAmount filled amount in base currency
+	BaseAmount Decimal
+	// CounterAmount filled amount in counter currency
+	CounterAmount Decimal
+	// AveragePrice volume weighted average price of the fill
+	AveragePrice Decimal
+	// BestPrice price of the first level
+	BestPrice Decimal
+	// WorstPrice price of the last level that was used
+	WorstPrice Decimal
+	// SlippageBps distance of average price from best price in basis points
+	SlippageBps Decimal
+	// Levels number of levels used
+	Levels int
+}
+
+// EstimateFill walks levels (best price first, asks for buying and bids for selling) and calculates the average
+// price of filling size. If levels do not have enough liquidity the partial fill is returned together with
+// ErrInsufficientLiquidity. Levels without a positive price are skipped.
+func EstimateFill(levels []PriceLevel, size Decimal, unit SizeUnit) (FillEstimate, error) {
+	var fill FillEstimate
+
+	if size.Sign() <= 0 {
+		return fill, fmt.Errorf("size must be positive, got %s", size)
+	}
+	if len(levels) == 0 {
+		return fill, fmt.Errorf("%w, order book side is empty", ErrInsufficientLiquidity)
+	}
+
+	remaining := size
+
+	for i := range levels {
+		if remaining.Sign() <= 0 {
+			break
+		}
+		// a level without a price cannot be converted between base and counter currency
+		if levels[i].Price.Sign() <= 0 {
+			continue
+		}
+		if fill.Levels == 0 {
+			fill.BestPrice = levels[i].Price
+		}
+
+		base, counter := levels[i].Amount, levels[i].Price.Mul(levels[i].Amount)
+
+		available := base
+		if unit == CounterSize {
+			available = counter
+		}
+
+		// partially consume the level
+		if available.Cmp(remaining) > 0 {
+			if unit == CounterSize {
+				counter = remaining
+				base = remaining.Div(levels[i].Price, 2*analyticsDecimals).Truncate(analyticsDecimals)
+			} else {
+				base = remaining
+				counter = remaining.Mul(levels[i].Price)
+			}
+			available = remaining
+		}
+
+		fill.BaseAmount = fill.BaseAmount.Add(base)
+		fill.CounterAmount = fill.CounterAmount.Add(counter)
+		fill.WorstPrice = levels[i].Price
+		fill.Levels++
+		remaining = remaining.Sub(available)
+	}
+
+	if !fill.BaseAmount.IsZero() {
+		fill.AveragePrice = fill.CounterAmount.Div(fill.BaseAmount, analyticsDecimals)
+		fill.SlippageBps = BasisPoints(fill.AveragePrice, fill.BestPrice)
+	}
+
+	if remaining.Sign() > 0 {
+		return fill, fmt.Errorf("%w, %s left unfilled", ErrInsufficientLiquidity, remaining)
+	}
+
+	return fill, nil
+}
+
+// BasisPoints returns the absolute distance of price from reference in basis points rounded to 2 decimal places
+func BasisPoints(price, reference Decimal) Decimal {
+	if reference.IsZero() {
+		return Decimal{}
+	}
+
+	return price.Sub(reference).Abs().Mul(NewDecimalFromInt(10000)).Div(reference, 2)
+}
+
+// MidPrice returns the average of best bid and best ask, false is returned if any of the sides is empty
+func MidPrice(bids, asks []PriceLevel) (Decimal, bool) {
+	if len(bids) == 0 || len(asks) == 0 {
+		return Decimal{}, false
+	}
+
+	bid, ask := bids[0].Price, asks[0].Price
+
+	return bid.Add(ask).Div(NewDecimalFromInt(2), maxScale(bid, ask)+1), true
+}
+
+// DepthWithin returns the cumulative base and counter amount of levels (best price first) whose price is within
+// percent of mid (Example: percent 0.5 sums all levels that are at most 0.5% away from mid)
+func DepthWithin(levels []PriceLevel, mid Decimal, percent Decimal) (Decimal, Decimal) {
+	var base, counter Decimal
+
+	maxDistance := mid.Mul(percent).Div(NewDecimalFromInt(100), mid.Scale()+percent.Scale()+2)
+
+	for i := range levels {
+		if levels[i].Price.Sub(mid).Abs().Cmp(maxDistance) > 0 {
+			break
+		}
+
+		base = base.Add(levels[i].Amount)
+		counter = counter.Add(levels[i].Price.Mul(levels[i].Amount))
+	}
+
+	return base, counter
+}
+
+// AggregateLevels merges orders with the same price into price levels, orders must be sorted by best price first
+// as returned by GetOrderBook and the detail order book channel
+func AggregateLevels(orders []OrderLevel) []PriceLevel {
+	levels := make([]PriceLevel, 0, len(orders))
+
+	for i := range orders {
+		if n := len(levels); n > 0 && levels[n-1].Price.Equal(orders[i].Price) {
+			levels[n-1].Amount = levels[n-1].Amount.Add(orders[i].Amount)
+			continue
+		}
+
+		levels = append(levels, PriceLevel{Price: orders[i].Price, Amount: orders[i].Amount})
+	}
+
+	return levels
+}
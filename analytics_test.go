@@ -0,0 +1,185 @@
+package bitstamp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/georlav/bitstamp"
+)
+
+func testLevels(values ...string) []bitstamp.PriceLevel {
+	levels := make([]bitstamp.PriceLevel, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		levels = append(levels, bitstamp.PriceLevel{
+			Price:  bitstamp.MustDecimal(values[i]),
+			Amount: bitstamp.MustDecimal(values[i+1]),
+		})
+	}
+
+	return levels
+}
+
+func TestEstimateFill(t *testing.T) {
+	asks := testLevels("100.00", "1.0", "101.00", "2.0", "105.00", "1.0")
+
+	testCases := []struct {
+		description      string
+		levels           []bitstamp.PriceLevel
+		size             string
+		unit             bitstamp.SizeUnit
+		expectedBase     string
+		expectedCounter  string
+		expectedAverage  string
+		expectedSlippage string
+		expectedLevels   int
+		expectedErr      error
+	}{
+		{
+			description:      "Should fill base size using two levels",
+			levels:           asks,
+			size:             "2.0",
+			unit:             bitstamp.BaseSize,
+			expectedBase:     "2.0",
+			expectedCounter:  "201.000",
+			expectedAverage:  "100.50000000",
+			expectedSlippage: "50.00",
+			expectedLevels:   2,
+		},
+		{
+			description:      "Should fill counter size consuming whole levels",
+			levels:           asks,
+			size:             "302.00",
+			unit:             bitstamp.CounterSize,
+			expectedBase:     "3.0",
+			expectedCounter:  "302.000",
+			expectedAverage:  "100.66666667",
+			expectedSlippage: "66.67",
+			expectedLevels:   2,
+		},
+		{
+			description:      "Should fill counter size partially consuming a level",
+			levels:           asks,
+			size:             "150.00",
+			unit:             bitstamp.CounterSize,
+			expectedBase:     "1.49504950",
+			expectedCounter:  "150.000",
+			expectedAverage:  "100.33112616",
+			expectedSlippage: "33.11",
+			expectedLevels:   2,
+		},
+		{
+			description:      "Should fill within best level without slippage",
+			levels:           asks,
+			size:             "0.5",
+			unit:             bitstamp.BaseSize,
+			expectedBase:     "0.5",
+			expectedCounter:  "50.000",
+			expectedAverage:  "100.00000000",
+			expectedSlippage: "0.00",
+			expectedLevels:   1,
+		},
+		{
+			description:      "Should return partial fill when there is not enough liquidity",
+			levels:           asks,
+			size:             "5",
+			unit:             bitstamp.BaseSize,
+			expectedBase:     "4.0",
+			expectedCounter:  "407.000",
+			expectedAverage:  "101.75000000",
+			expectedSlippage: "175.00",
+			expectedLevels:   3,
+			expectedErr:      bitstamp.ErrInsufficientLiquidity,
+		},
+		{
+			description:      "Should skip levels with zero price",
+			levels:           testLevels("0", "1.0", "100.00", "1.0", "101.00", "2.0"),
+			size:             "150.00",
+			unit:             bitstamp.CounterSize,
+			expectedBase:     "1.49504950",
+			expectedCounter:  "150.000",
+			expectedAverage:  "100.33112616",
+			expectedSlippage: "33.11",
+			expectedLevels:   2,
+		},
+		{
+			description: "Should fail on empty side",
+			size:        "1",
+			unit:        bitstamp.BaseSize,
+			expectedErr: bitstamp.ErrInsufficientLiquidity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			fill, err := bitstamp.EstimateFill(tc.levels, bitstamp.MustDecimal(tc.size), tc.unit)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("Expected error %v got %v", tc.expectedErr, err)
+			}
+			if tc.levels == nil {
+				return
+			}
+
+			if fill.BaseAmount.String() != tc.expectedBase {
+				t.Fatalf("Expected base amount %s got %s", tc.expectedBase, fill.BaseAmount)
+			}
+			if fill.CounterAmount.String() != tc.expectedCounter {
+				t.Fatalf("Expected counter amount %s got %s", tc.expectedCounter, fill.CounterAmount)
+			}
+			if fill.AveragePrice.String() != tc.expectedAverage {
+				t.Fatalf("Expected average price %s got %s", tc.expectedAverage, fill.AveragePrice)
+			}
+			if fill.SlippageBps.String() != tc.expectedSlippage {
+				t.Fatalf("Expected slippage %s got %s", tc.expectedSlippage, fill.SlippageBps)
+			}
+			if fill.Levels != tc.expectedLevels {
+				t.Fatalf("Expected %d levels got %d", tc.expectedLevels, fill.Levels)
+			}
+		})
+	}
+}
+
+func TestEstimateFill_InvalidSize(t *testing.T) {
+	if _, err := bitstamp.EstimateFill(testLevels("100", "1"), bitstamp.MustDecimal("0"), bitstamp.BaseSize); err == nil {
+		t.Fatal("Expected an error for zero size")
+	}
+}
+
+func TestMidPriceAndDepthWithin(t *testing.T) {
+	bids := testLevels("99.00", "1.0", "98.00", "3.0")
+	asks := testLevels("100.00", "0.5", "101.00", "1.5", "105.00", "1.0")
+
+	mid, ok := bitstamp.MidPrice(bids, asks)
+	if !ok || mid.String() != "99.500" {
+		t.Fatalf("Expected mid price 99.500 got %s", mid)
+	}
+
+	if _, ok := bitstamp.MidPrice(nil, asks); ok {
+		t.Fatal("Expected no mid price when bids are empty")
+	}
+
+	base, counter := bitstamp.DepthWithin(asks, mid, bitstamp.MustDecimal("2"))
+	if base.String() != "2.0" || counter.String() != "201.500" {
+		t.Fatalf("Expected ask depth 2.0/201.500 got %s/%s", base, counter)
+	}
+
+	base, counter = bitstamp.DepthWithin(bids, mid, bitstamp.MustDecimal("1"))
+	if base.String() != "1.0" || counter.String() != "99.000" {
+		t.Fatalf("Expected bid depth 1.0/99.000 got %s/%s", base, counter)
+	}
+}
+
+func TestAggregateLevels(t *testing.T) {
+	orders := []bitstamp.OrderLevel{
+		{Price: bitstamp.MustDecimal("100.00"), Amount: bitstamp.MustDecimal("1.0"), OrderID: 1},
+		{Price: bitstamp.MustDecimal("100.0"), Amount: bitstamp.MustDecimal("0.5"), OrderID: 2},
+		{Price: bitstamp.MustDecimal("101.00"), Amount: bitstamp.MustDecimal("2.0"), OrderID: 3},
+	}
+
+	levels := bitstamp.AggregateLevels(orders)
+	if len(levels) != 2 {
+		t.Fatalf("Expected 2 levels got %d", len(levels))
+	}
+	if levels[0].Amount.String() != "1.5" || levels[1].Price.String() != "101.00" {
+		t.Fatalf("Unexpected levels %+v", levels)
+	}
+}
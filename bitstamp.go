@@ -17,21 +17,30 @@ const (
 	eurusdConversionRateURL = `/api/v2/eur_usd/`
 
 	// Private API urls
-	accountBalanceURL        = `/api/v2/balance/`
-	userTransactionsURL      = `/api/v2/user_transactions/`
-	cryptoTransactionsURL    = `/api/v2/crypto-transactions/`
-	openOrdersURL            = `/api/v2/open_orders/all/`
-	orderStatusURL           = `/api/v2/order_status/`
-	cancelOrderURL           = `/api/v2/cancel_order/`
-	cancelAllOrdersURL       = `/api/v2/cancel_all_orders/`
-	buyLimitOrderURL         = `/api/v2/buy/%s/`
-	buyMarketOrderURL        = `/api/v2/buy/market/%s/`
-	buyInstantOrderURL       = `/api/v2/buy/instant/%s/`
-	sellLimitOrderURL        = `/api/v2/sell/%s/`
-	sellMarketOrderURL       = `/api/v2/sell/market/%s/`
-	sellInstantOrderURL      = `/api/v2/sell/instant/%s/`
-	withdrawalRequestsURL    = `/api/v2/withdrawal-requests/`
-	bitcoinCashWithdrawalURL = `/api/v2/bch_withdrawal/`
-	bitcoinWithdrawalURL     = `/api/v2/btc_withdrawal/`
-	websocketsTokenURL       = `/api/v2/websockets_token/`
+	accountBalanceURL         = `/api/v2/balance/`
+	userTransactionsURL       = `/api/v2/user_transactions/`
+	cryptoTransactionsURL     = `/api/v2/crypto-transactions/`
+	cryptoDepositAddressURL   = `/api/v2/%s_address/`
+	openOrdersURL             = `/api/v2/open_orders/all/`
+	orderStatusURL            = `/api/v2/order_status/`
+	cancelOrderURL            = `/api/v2/cancel_order/`
+	cancelAllOrdersURL        = `/api/v2/cancel_all_orders/`
+	buyLimitOrderURL          = `/api/v2/buy/%s/`
+	buyMarketOrderURL         = `/api/v2/buy/market/%s/`
+	buyInstantOrderURL        = `/api/v2/buy/instant/%s/`
+	sellLimitOrderURL         = `/api/v2/sell/%s/`
+	sellMarketOrderURL        = `/api/v2/sell/market/%s/`
+	sellInstantOrderURL       = `/api/v2/sell/instant/%s/`
+	withdrawalRequestsURL     = `/api/v2/withdrawal-requests/`
+	cryptoWithdrawalURL       = `/api/v2/%s_withdrawal/`
+	transferToMainURL         = `/api/v2/transfer-to-main/`
+	transferFromMainURL       = `/api/v2/transfer-from-main/`
+	openBankWithdrawalURL     = `/api/v2/withdrawal/open/`
+	bankWithdrawalStatusURL   = `/api/v2/withdrawal/status/`
+	cancelBankWithdrawalURL   = `/api/v2/withdrawal/cancel/`
+	newLiquidationAddressURL  = `/api/v2/liquidation_address/new/`
+	liquidationAddressInfoURL = `/api/v2/liquidation_address/info/`
+	tradingFeesURL            = `/api/v2/fees/trading/`
+	tradingFeeURL             = `/api/v2/fees/trading/%s/`
+	websocketsTokenURL        = `/api/v2/websockets_token/`
 )
@@ -0,0 +1,533 @@
+package bitstamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	ErrBrokerClosed     = errors.New("broker is closed")
+	ErrSubscriberClosed = errors.New("broker subscriber is closed")
+)
+
+type brokerOption func(*Broker)
+
+// BrokerConnectionOptions options used to create every upstream websocket connection
+func BrokerConnectionOptions(opts ...wsOption) brokerOption {
+	return func(b *Broker) {
+		b.wsOpts = opts
+	}
+}
+
+// BrokerMaxChannelsOption limit the channels subscribed on a single connection, a new connection is added to the
+// pool when all connections are full. Zero means unlimited (default).
+func BrokerMaxChannelsOption(n int) brokerOption {
+	return func(b *Broker) {
+		b.maxChannels = n
+	}
+}
+
+// BrokerBufferOption buffer size and overflow policy of each subscriber, see BufferOption. Default is unbuffered
+// using OverflowBlock, a slow subscriber then stalls all subscribers of the same connection.
+func BrokerBufferOption(size int, policy OverflowPolicy) brokerOption {
+	return func(b *Broker) {
+		b.buffer = bufferConfig{size: size, policy: policy}
+	}
+}
+
+// Broker shares websocket connections among many subscribers. Channel subscriptions are reference counted, a
+// channel is subscribed upstream by its first subscriber and unsubscribed when its last subscriber leaves.
+//
+// When a connection is lost its subscribers receive the error that closed it and their message channel is closed,
+// use AutoReconnectOption with BrokerConnectionOptions to reconnect and resubscribe instead.
+type Broker struct {
+	wsOpts      []wsOption
+	maxChannels int
+	buffer      bufferConfig
+	ctx         context.Context
+	cancel      context.CancelFunc
+	// names maps channel names to channels
+	names map[string]Channel
+
+	// mu guards all fields below and the state of connections, it is never held during network calls
+	mu     sync.Mutex
+	conns  []*brokerConn
+	routes map[Channel]*brokerRoute
+	// locks serialize subscription changes per channel, a lock is held while the request is sent upstream
+	locks  map[Channel]chan struct{}
+	closed bool
+}
+
+// brokerConn an upstream connection, ws is set when ready is closed and err is nil
+type brokerConn struct {
+	ws    *WebsocketAPI
+	ready chan struct{}
+	err   error
+	// channels subscribed or being subscribed on the connection
+	channels int
+}
+
+// brokerRoute subscribers of a channel and the connection it is subscribed on
+type brokerRoute struct {
+	conn *brokerConn
+	subs map[*BrokerSubscriber]struct{}
+}
+
+// BrokerSubscriber receives the messages of its channels, messages without a channel (errors and reconnect
+// notifications) of the connections it uses are also delivered.
+type BrokerSubscriber struct {
+	counters messageCounters
+	broker   *Broker
+	in       chan WebsocketMessage
+	messages chan WebsocketMessage
+	ctx      context.Context
+	cancel   context.CancelFunc
+	// mu guards closing in, deliveries hold it for reading
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewBroker creates a broker, connections are created on demand
+func NewBroker(opts ...brokerOption) *Broker {
+	b := Broker{
+		names:  make(map[string]Channel),
+		routes: make(map[Channel]*brokerRoute),
+		locks:  make(map[Channel]chan struct{}),
+	}
+
+	// override defaults via available functional options
+	for i := range opts {
+		opts[i](&b)
+	}
+
+	for c, name := range getChannels() {
+		b.names[name] = c
+	}
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+
+	return &b
+}
+
+// Subscribe creates a subscriber for the given channels, more channels can be added later using
+// BrokerSubscriber.Subscribe
+func (b *Broker) Subscribe(ctx context.Context, channels ...Channel) (*BrokerSubscriber, error) {
+	b.mu.Lock()
+	closed := b.closed
+	b.mu.Unlock()
+	if closed {
+		return nil, ErrBrokerClosed
+	}
+
+	s := BrokerSubscriber{
+		broker:   b,
+		in:       make(chan WebsocketMessage),
+		messages: make(chan WebsocketMessage),
+	}
+	s.ctx, s.cancel = context.WithCancel(b.ctx)
+
+	go func() {
+		defer s.cancel()
+		pump(s.ctx, s.in, s.messages, b.buffer, &s.counters, nil)
+	}()
+
+	if err := s.Subscribe(ctx, channels...); err != nil {
+		_ = s.Close(ctx)
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Connections returns the number of upstream connections
+func (b *Broker) Connections() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.conns)
+}
+
+// Close closes all connections and the message channels of all subscribers
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	b.cancel()
+	conns := b.conns
+	b.conns = nil
+	b.mu.Unlock()
+
+	var err error
+	for i := range conns {
+		<-conns[i].ready
+		if conns[i].ws == nil {
+			continue
+		}
+		if cerr := conns[i].ws.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
+// Messages returns the channel messages are delivered to, it is closed when the subscriber, its connection or the
+// broker is closed
+func (s *BrokerSubscriber) Messages() <-chan WebsocketMessage {
+	return s.messages
+}
+
+// Subscribe adds channels to the subscriber
+func (s *BrokerSubscriber) Subscribe(ctx context.Context, channels ...Channel) error {
+	for _, c := range channels {
+		if err := s.broker.subscribe(ctx, s, c); err != nil {
+			return fmt.Errorf("failed to subscribe to channel %s, %w", c.String(), err)
+		}
+	}
+
+	return nil
+}
+
+// Unsubscribe removes channels from the subscriber
+func (s *BrokerSubscriber) Unsubscribe(ctx context.Context, channels ...Channel) error {
+	for _, c := range channels {
+		if err := s.broker.unsubscribe(ctx, s, c); err != nil {
+			return fmt.Errorf("failed to unsubscribe from channel %s, %w", c.String(), err)
+		}
+	}
+
+	return nil
+}
+
+// Close unsubscribes from all channels and closes the message channel
+func (s *BrokerSubscriber) Close(ctx context.Context) error {
+	defer s.cancel()
+
+	return s.Unsubscribe(ctx, s.broker.channels(s)...)
+}
+
+// DroppedMessages returns the number of messages discarded by the overflow policy (see BrokerBufferOption)
+func (s *BrokerSubscriber) DroppedMessages() uint64 {
+	return atomic.LoadUint64(&s.counters.dropped)
+}
+
+// CoalescedMessages returns the number of order book snapshots replaced by newer ones (see OverflowCoalesce)
+func (s *BrokerSubscriber) CoalescedMessages() uint64 {
+	return atomic.LoadUint64(&s.counters.coalesced)
+}
+
+// deliver sends a message to the subscriber unless it is closed
+func (s *BrokerSubscriber) deliver(m WebsocketMessage) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.in <- m:
+	case <-s.ctx.Done():
+	}
+}
+
+// terminate stops deliveries, messages that are already buffered are delivered before the message channel closes
+func (s *BrokerSubscriber) terminate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.in)
+	}
+}
+
+func (s *BrokerSubscriber) isClosed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.closed || s.ctx.Err() != nil
+}
+
+func (b *Broker) subscribe(ctx context.Context, s *BrokerSubscriber, c Channel) error {
+	unlock, err := b.lockChannel(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if s.isClosed() {
+		return ErrSubscriberClosed
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return ErrBrokerClosed
+	}
+
+	if r, ok := b.routes[c]; ok {
+		r.subs[s] = struct{}{}
+		b.mu.Unlock()
+		return nil
+	}
+
+	conn, dial := b.connection()
+	conn.channels++
+
+	// route is added before subscribing so confirmations reach the subscriber
+	r := brokerRoute{conn: conn, subs: map[*BrokerSubscriber]struct{}{s: {}}}
+	b.routes[c] = &r
+	b.mu.Unlock()
+
+	if dial {
+		b.dial(ctx, conn)
+	}
+
+	select {
+	case <-conn.ready:
+		err = conn.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if err == nil {
+		err = conn.ws.SubscribeToChannels(ctx, c)
+	}
+
+	if err != nil {
+		b.mu.Lock()
+		if b.routes[c] == &r {
+			delete(b.routes, c)
+		}
+		conn.channels--
+		b.mu.Unlock()
+	}
+
+	return err
+}
+
+func (b *Broker) unsubscribe(ctx context.Context, s *BrokerSubscriber, c Channel) error {
+	unlock, err := b.lockChannel(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	b.mu.Lock()
+	r, ok := b.routes[c]
+	if ok {
+		_, ok = r.subs[s]
+	}
+	if !ok {
+		b.mu.Unlock()
+		return nil
+	}
+
+	delete(r.subs, s)
+	if len(r.subs) > 0 || b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+
+	delete(b.routes, c)
+	conn := r.conn
+	conn.channels--
+
+	// keep one idle connection around for future subscriptions
+	idle := conn.channels == 0 && len(b.conns) > 1
+	if idle {
+		b.removeConn(conn)
+	}
+	b.mu.Unlock()
+
+	err = conn.ws.UnSubscribeFromChannels(ctx, c)
+	if idle {
+		_ = conn.ws.Close()
+	}
+
+	return err
+}
+
+// lockChannel acquires the subscription lock of a channel, the returned function releases it
+func (b *Broker) lockChannel(ctx context.Context, c Channel) (func(), error) {
+	b.mu.Lock()
+	l, ok := b.locks[c]
+	if !ok {
+		l = make(chan struct{}, 1)
+		b.locks[c] = l
+	}
+	b.mu.Unlock()
+
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// channels returns the channels of a subscriber
+func (b *Broker) channels(s *BrokerSubscriber) []Channel {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var result []Channel
+	for c, r := range b.routes {
+		if _, ok := r.subs[s]; ok {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// connection returns a connection that can subscribe to one more channel, when all connections are full a pending
+// connection is added to the pool and dial is true, the caller must then call Broker.dial. It must be called while
+// holding mu.
+func (b *Broker) connection() (conn *brokerConn, dial bool) {
+	for i := range b.conns {
+		if b.maxChannels <= 0 || b.conns[i].channels < b.maxChannels {
+			return b.conns[i], false
+		}
+	}
+
+	conn = &brokerConn{ready: make(chan struct{})}
+	b.conns = append(b.conns, conn)
+
+	return conn, true
+}
+
+// dial connects a pending connection using the ctx of the subscriber that needs it and starts dispatching its
+// messages, a connection that fails is removed from the pool
+func (b *Broker) dial(ctx context.Context, conn *brokerConn) {
+	opts := append(append([]wsOption{}, b.wsOpts...), DeferConnectOption())
+	ws, err := NewWebsocketAPI(opts...)
+
+	var messages <-chan WebsocketMessage
+	if err == nil {
+		err = ws.Connect(ctx)
+		if err == nil {
+			messages, err = ws.Consume(b.ctx)
+		}
+		if err != nil {
+			_ = ws.Close()
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	defer close(conn.ready)
+
+	if err != nil {
+		conn.err = err
+		b.removeConn(conn)
+		return
+	}
+
+	conn.ws = ws
+	go b.dispatch(conn, messages)
+}
+
+// removeConn removes a connection from the pool, it must be called while holding mu
+func (b *Broker) removeConn(conn *brokerConn) {
+	for i := range b.conns {
+		if b.conns[i] == conn {
+			b.conns = append(b.conns[:i], b.conns[i+1:]...)
+			break
+		}
+	}
+}
+
+// dispatch delivers the messages of a connection to subscribers until the stream is closed. The subscribers of a
+// lost connection have already received the error that closed it, they are terminated and their channels on other
+// connections are released.
+func (b *Broker) dispatch(conn *brokerConn, messages <-chan WebsocketMessage) {
+	for m := range messages {
+		for _, s := range b.subscribers(conn, m) {
+			s.deliver(m)
+		}
+	}
+
+	b.mu.Lock()
+	b.removeConn(conn)
+
+	lost := make(map[*BrokerSubscriber]struct{})
+	for c, r := range b.routes {
+		if r.conn != conn {
+			continue
+		}
+		for s := range r.subs {
+			lost[s] = struct{}{}
+		}
+		delete(b.routes, c)
+	}
+	closed := b.closed
+	b.mu.Unlock()
+
+	_ = conn.ws.Close()
+
+	if closed {
+		return
+	}
+
+	for s := range lost {
+		s.terminate()
+		_ = s.Unsubscribe(b.ctx, b.channels(s)...)
+	}
+}
+
+// subscribers returns the subscribers a message must be delivered to, messages without a channel are delivered to
+// all subscribers of the connection
+func (b *Broker) subscribers(conn *brokerConn, m WebsocketMessage) []*BrokerSubscriber {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var result []*BrokerSubscriber
+
+	name := messageChannel(m.Message)
+	if name == "" {
+		seen := make(map[*BrokerSubscriber]struct{})
+		for _, r := range b.routes {
+			if r.conn != conn {
+				continue
+			}
+			for s := range r.subs {
+				if _, ok := seen[s]; !ok {
+					seen[s] = struct{}{}
+					result = append(result, s)
+				}
+			}
+		}
+
+		return result
+	}
+
+	// private channel names are suffixed with the user id
+	if strings.HasPrefix(name, privateChannelPrefix) {
+		if i := strings.LastIndexByte(name, '-'); i > len(privateChannelPrefix) {
+			name = name[:i]
+		}
+	}
+
+	c, ok := b.names[name]
+	if !ok {
+		return nil
+	}
+
+	r, ok := b.routes[c]
+	if !ok || r.conn != conn {
+		return nil
+	}
+
+	for s := range r.subs {
+		result = append(result, s)
+	}
+
+	return result
+}
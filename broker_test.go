@@ -0,0 +1,362 @@
+package bitstamp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/georlav/bitstamp"
+	"github.com/gorilla/websocket"
+)
+
+// newBrokerServer starts a websocket server that reports subscription requests as "n event channel" and writes
+// pushed messages to the connection that reads them, pushing "close" drops the connection. When confirm is true
+// subscriptions are confirmed except for live_trades_xrpusd.
+func newBrokerServer(t *testing.T, confirm bool) (string, <-chan string, chan<- string) {
+	t.Helper()
+
+	var (
+		requests = make(chan string, 100)
+		push     = make(chan string)
+	)
+
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		done := make(chan struct{})
+		defer close(done)
+
+		writes := make(chan string, 10)
+		go func() {
+			for {
+				var m string
+				select {
+				case m = <-push:
+				case m = <-writes:
+				case <-done:
+					return
+				}
+
+				if m == "close" {
+					_ = c.UnderlyingConn().Close()
+					return
+				}
+				_ = c.WriteMessage(websocket.TextMessage, []byte(m))
+			}
+		}()
+
+		for {
+			_, msg, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var req struct {
+				Event string `json:"event"`
+				Data  struct {
+					Channel string `json:"channel"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(msg, &req); err != nil {
+				t.Errorf("failed to decode request, %s", err)
+				return
+			}
+			requests <- fmt.Sprintf("%d %s %s", n, req.Event, req.Data.Channel)
+
+			if confirm && req.Event == "bts:subscribe" && req.Data.Channel != "live_trades_xrpusd" {
+				writes <- `{"event":"bts:subscription_succeeded","channel":"` + req.Data.Channel + `","data":{}}`
+			}
+		}
+	})
+
+	return address, requests, push
+}
+
+func expectRequest(t *testing.T, requests <-chan string, expected string) {
+	t.Helper()
+
+	select {
+	case r := <-requests:
+		if r != expected {
+			t.Fatalf("Expected request %s got %s", expected, r)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for request %s", expected)
+	}
+}
+
+func expectNoRequest(t *testing.T, requests <-chan string) {
+	t.Helper()
+
+	select {
+	case r := <-requests:
+		t.Fatalf("Expected no request got %s", r)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBroker_Subscribe(t *testing.T) {
+	address, requests, push := newBrokerServer(t, false)
+
+	broker := bitstamp.NewBroker(
+		bitstamp.BrokerConnectionOptions(bitstamp.SetWSAddressOption(address)),
+	)
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub1, err := broker.Subscribe(ctx, bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to subscribe, %s", err)
+	}
+	expectRequest(t, requests, "1 bts:subscribe live_trades_btcusd")
+
+	sub2, err := broker.Subscribe(ctx, bitstamp.LiveTradesBTCUSDChannel, bitstamp.LiveOrdersBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to subscribe, %s", err)
+	}
+	expectRequest(t, requests, "1 bts:subscribe live_orders_btcusd")
+
+	push <- testTradeMessage
+	for _, sub := range []*bitstamp.BrokerSubscriber{sub1, sub2} {
+		if m := nextMessage(t, sub.Messages()); m.Error != nil || m.Message.(bitstamp.LiveTickerChannel).Data.ID != 1 {
+			t.Fatalf("Expected trade message got %+v", m)
+		}
+	}
+
+	push <- liveOrderMessage("btcusd", "order_created", 1, 0, "100", "1", 1637511612000001)
+	if m := nextMessage(t, sub2.Messages()); m.Error != nil || m.Message.(bitstamp.LiveOrdersChannel).Data.ID != 1 {
+		t.Fatalf("Expected order message got %+v", m)
+	}
+
+	// channel is still used by sub2
+	if err := sub1.Close(ctx); err != nil {
+		t.Fatalf("failed to close subscriber, %s", err)
+	}
+	expectNoRequest(t, requests)
+
+	if _, ok := <-sub1.Messages(); ok {
+		t.Fatal("Expected closed message channel")
+	}
+
+	if err := sub2.Unsubscribe(ctx, bitstamp.LiveTradesBTCUSDChannel); err != nil {
+		t.Fatalf("failed to unsubscribe, %s", err)
+	}
+	expectRequest(t, requests, "1 bts:unsubscribe live_trades_btcusd")
+
+	if err := sub2.Close(ctx); err != nil {
+		t.Fatalf("failed to close subscriber, %s", err)
+	}
+	expectRequest(t, requests, "1 bts:unsubscribe live_orders_btcusd")
+
+	if err := broker.Close(); err != nil {
+		t.Fatalf("failed to close broker, %s", err)
+	}
+
+	if _, err := broker.Subscribe(ctx, bitstamp.LiveTradesBTCUSDChannel); err != bitstamp.ErrBrokerClosed {
+		t.Fatalf("Expected %s got %v", bitstamp.ErrBrokerClosed, err)
+	}
+}
+
+func TestBroker_Pool(t *testing.T) {
+	address, requests, _ := newBrokerServer(t, false)
+
+	broker := bitstamp.NewBroker(
+		bitstamp.BrokerConnectionOptions(bitstamp.SetWSAddressOption(address)),
+		bitstamp.BrokerMaxChannelsOption(1),
+	)
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub, err := broker.Subscribe(ctx, bitstamp.LiveTradesBTCUSDChannel, bitstamp.LiveTradesETHUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to subscribe, %s", err)
+	}
+	expectRequest(t, requests, "1 bts:subscribe live_trades_btcusd")
+	expectRequest(t, requests, "2 bts:subscribe live_trades_ethusd")
+
+	if n := broker.Connections(); n != 2 {
+		t.Fatalf("Expected 2 connections got %d", n)
+	}
+
+	if err := sub.Unsubscribe(ctx, bitstamp.LiveTradesETHUSDChannel); err != nil {
+		t.Fatalf("failed to unsubscribe, %s", err)
+	}
+	expectRequest(t, requests, "2 bts:unsubscribe live_trades_ethusd")
+
+	if n := broker.Connections(); n != 1 {
+		t.Fatalf("Expected 1 connection got %d", n)
+	}
+
+	// remaining connection is full so a new one is created
+	if err := sub.Subscribe(ctx, bitstamp.LiveTradesETHUSDChannel); err != nil {
+		t.Fatalf("failed to subscribe, %s", err)
+	}
+	expectRequest(t, requests, "3 bts:subscribe live_trades_ethusd")
+}
+
+func TestBroker_Subscribe_SlowAck(t *testing.T) {
+	address, _, push := newBrokerServer(t, true)
+
+	broker := bitstamp.NewBroker(
+		bitstamp.BrokerConnectionOptions(bitstamp.SetWSAddressOption(address), bitstamp.SubscriptionAckOption()),
+	)
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// confirmation of live_trades_xrpusd never arrives
+	slow := make(chan error, 1)
+	go func() {
+		_, err := broker.Subscribe(ctx, bitstamp.LiveTradesXRPUSDChannel)
+		slow <- err
+	}()
+
+	sub, err := broker.Subscribe(ctx, bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to subscribe, %s", err)
+	}
+
+	push <- testTradeMessage
+	for {
+		m := nextMessage(t, sub.Messages())
+		if _, ok := m.Message.(bitstamp.LiveTickerChannel); ok {
+			break
+		}
+	}
+
+	select {
+	case err := <-slow:
+		t.Fatalf("Expected slow subscription to be pending got %v", err)
+	default:
+	}
+
+	cancel()
+	if err := <-slow; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected error %s got %v", context.Canceled, err)
+	}
+}
+
+func TestBroker_Subscribe_DialCancelled(t *testing.T) {
+	// accept connections but never complete the websocket handshake
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen, %s", err)
+	}
+	conns := make(chan net.Conn, 10)
+	t.Cleanup(func() {
+		_ = l.Close()
+		for c := range conns {
+			_ = c.Close()
+		}
+	})
+	go func() {
+		defer close(conns)
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conns <- c
+		}
+	}()
+
+	broker := bitstamp.NewBroker(
+		bitstamp.BrokerConnectionOptions(bitstamp.SetWSAddressOption("ws://" + l.Addr().String())),
+	)
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := broker.Subscribe(ctx, bitstamp.LiveTradesBTCUSDChannel)
+		done <- err
+	}()
+
+	// the dialer reports the cancelled handshake as a timeout
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("Expected subscription to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected cancelling ctx to abort the dial")
+	}
+}
+
+func TestBroker_ConnectionLost(t *testing.T) {
+	testCases := []struct {
+		description string
+		reconnect   bool
+	}{
+		{description: "Should close subscribers with the connection error"},
+		{description: "Should keep subscribers after reconnecting", reconnect: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			address, requests, push := newBrokerServer(t, false)
+
+			broker := bitstamp.NewBroker(
+				bitstamp.BrokerConnectionOptions(bitstamp.SetWSAddressOption(address)),
+			)
+			if tc.reconnect {
+				broker = bitstamp.NewBroker(
+					bitstamp.BrokerConnectionOptions(
+						bitstamp.SetWSAddressOption(address),
+						bitstamp.AutoReconnectOption(),
+						bitstamp.ReconnectBackoffOption(time.Millisecond, 10*time.Millisecond),
+					),
+				)
+			}
+			defer broker.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			sub, err := broker.Subscribe(ctx, bitstamp.LiveTradesBTCUSDChannel)
+			if err != nil {
+				t.Fatalf("failed to subscribe, %s", err)
+			}
+			expectRequest(t, requests, "1 bts:subscribe live_trades_btcusd")
+
+			push <- "close"
+
+			if !tc.reconnect {
+				if m := nextMessage(t, sub.Messages()); !errors.Is(m.Error, bitstamp.ErrReadMessage) {
+					t.Fatalf("Expected read error got %+v", m)
+				}
+				if _, ok := <-sub.Messages(); ok {
+					t.Fatal("Expected closed message channel")
+				}
+				if n := broker.Connections(); n != 0 {
+					t.Fatalf("Expected no connections got %d", n)
+				}
+				if err := sub.Subscribe(ctx, bitstamp.LiveTradesETHUSDChannel); !errors.Is(err, bitstamp.ErrSubscriberClosed) {
+					t.Fatalf("Expected %s got %v", bitstamp.ErrSubscriberClosed, err)
+				}
+				return
+			}
+
+			if m := nextMessage(t, sub.Messages()); m.Error != nil {
+				t.Fatalf("Expected reconnect message got %+v", m)
+			} else if _, ok := m.Message.(bitstamp.WebsocketReconnect); !ok {
+				t.Fatalf("Expected reconnect message got %+v", m)
+			}
+			expectRequest(t, requests, "2 bts:subscribe live_trades_btcusd")
+
+			push <- testTradeMessage
+			if m := nextMessage(t, sub.Messages()); m.Error != nil || m.Message.(bitstamp.LiveTickerChannel).Data.ID != 1 {
+				t.Fatalf("Expected trade message got %+v", m)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package bitstamp
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what happens to messages when the consumer of Consume is slower than the connection and
+// the message buffer is full
+type OverflowPolicy int
+
+const (
+	// OverflowBlock stop reading from the connection until the consumer catches up (default). A consumer that is
+	// slow for too long will eventually be disconnected by Bitstamp.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest discard the oldest buffered message to make room for the new one
+	OverflowDropOldest
+	// OverflowDropNewest discard the new message
+	OverflowDropNewest
+	// OverflowCoalesce replace a buffered order_book or detail_order_book snapshot with a newer snapshot of the
+	// same channel, other messages block when the buffer is full
+	OverflowCoalesce
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowBlock:
+		return "block"
+	case OverflowDropOldest:
+		return "drop oldest"
+	case OverflowDropNewest:
+		return "drop newest"
+	case OverflowCoalesce:
+		return "coalesce"
+	}
+
+	return "unknown"
+}
+
+// messageCounters messages discarded by an overflow policy, fields are accessed atomically. It must be the first
+// field of the struct that contains it so that the counters are 64 bit aligned on 32 bit platforms.
+type messageCounters struct {
+	dropped   uint64
+	coalesced uint64
+}
+
+type bufferConfig struct {
+	size   int
+	policy OverflowPolicy
+}
+
+// pump receives messages from in, passes them to process and delivers them to out applying the buffer config
+// until ctx is done or in is closed and all buffered messages are delivered. out is closed on return.
+func pump(ctx context.Context, in <-chan WebsocketMessage, out chan<- WebsocketMessage, cfg bufferConfig,
+	counters *messageCounters, process func(m *WebsocketMessage)) {
+	defer close(out)
+
+	queue := newMessageQueue(cfg)
+
+	for {
+		var (
+			send chan<- WebsocketMessage
+			next WebsocketMessage
+			recv = in
+		)
+
+		if queue.len() > 0 {
+			send, next = out, queue.peek()
+		} else if in == nil {
+			return
+		}
+
+		// stop receiving when blocking, the sender stalls until the consumer catches up
+		if cfg.policy == OverflowBlock && queue.full() {
+			recv = nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case send <- next:
+			queue.pop()
+
+		case m, ok := <-recv:
+			if !ok {
+				// deliver buffered messages before closing out
+				in = nil
+				continue
+			}
+
+			if process != nil {
+				process(&m)
+			}
+
+			for !queue.push(m, counters) {
+				select {
+				case out <- queue.peek():
+					queue.pop()
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// messageQueue buffers parsed messages until the consumer receives them, it is owned by a single goroutine
+type messageQueue struct {
+	bufferConfig
+	messages []WebsocketMessage
+}
+
+func newMessageQueue(cfg bufferConfig) *messageQueue {
+	if cfg.size < 1 {
+		cfg.size = 1
+	}
+
+	return &messageQueue{bufferConfig: cfg}
+}
+
+func (q *messageQueue) len() int {
+	return len(q.messages)
+}
+
+func (q *messageQueue) full() bool {
+	return len(q.messages) >= q.size
+}
+
+func (q *messageQueue) peek() WebsocketMessage {
+	return q.messages[0]
+}
+
+func (q *messageQueue) pop() {
+	q.messages[0] = WebsocketMessage{}
+	q.messages = q.messages[1:]
+}
+
+// push adds a message applying the overflow policy, it returns false when the queue is full and the message must
+// wait for the consumer. Errors and reconnect notifications are never dropped.
+func (q *messageQueue) push(m WebsocketMessage, counters *messageCounters) bool {
+	if q.policy == OverflowCoalesce {
+		if i := q.snapshotIndex(m); i != -1 {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+			q.messages = append(q.messages, m)
+			atomic.AddUint64(&counters.coalesced, 1)
+			return true
+		}
+	}
+
+	if !q.full() || !droppable(m) {
+		q.messages = append(q.messages, m)
+		return true
+	}
+
+	switch q.policy {
+	case OverflowDropNewest:
+		atomic.AddUint64(&counters.dropped, 1)
+		return true
+
+	case OverflowDropOldest:
+		for i := range q.messages {
+			if droppable(q.messages[i]) {
+				q.messages = append(q.messages[:i], q.messages[i+1:]...)
+				q.messages = append(q.messages, m)
+				atomic.AddUint64(&counters.dropped, 1)
+				return true
+			}
+		}
+		q.messages = append(q.messages, m)
+		return true
+	}
+
+	return false
+}
+
+// snapshotIndex returns the index of a buffered snapshot of the same channel as m, -1 if m is not a snapshot or
+// there is none
+func (q *messageQueue) snapshotIndex(m WebsocketMessage) int {
+	if !isSnapshot(m) {
+		return -1
+	}
+
+	name := messageChannel(m.Message)
+	for i := range q.messages {
+		if isSnapshot(q.messages[i]) && messageChannel(q.messages[i].Message) == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isSnapshot(m WebsocketMessage) bool {
+	if m.Error != nil {
+		return false
+	}
+
+	switch m.Message.(type) {
+	case LiveOrderBookChannel, LiveDetailOrderBookChannel:
+		return true
+	}
+
+	return false
+}
+
+// droppable reports whether a message can be discarded by an overflow policy
+func droppable(m WebsocketMessage) bool {
+	if m.Error != nil {
+		return false
+	}
+	_, reconnect := m.Message.(WebsocketReconnect)
+
+	return !reconnect
+}
@@ -9,866 +9,1212 @@ const (
 	OrderBookAAVEBTCChannel
 	DetailOrderBookAAVEBTCChannel
 	DiffOrderBookAAVEBTCChannel
+	MyOrdersAAVEBTCPrivateChannel
+	MyTradesAAVEBTCPrivateChannel
 	LiveTradesAAVEEURChannel
 	LiveOrdersAAVEEURChannel
 	OrderBookAAVEEURChannel
 	DetailOrderBookAAVEEURChannel
 	DiffOrderBookAAVEEURChannel
+	MyOrdersAAVEEURPrivateChannel
+	MyTradesAAVEEURPrivateChannel
 	LiveTradesAAVEUSDChannel
 	LiveOrdersAAVEUSDChannel
 	OrderBookAAVEUSDChannel
 	DetailOrderBookAAVEUSDChannel
 	DiffOrderBookAAVEUSDChannel
+	MyOrdersAAVEUSDPrivateChannel
+	MyTradesAAVEUSDPrivateChannel
 	LiveTradesADABTCChannel
 	LiveOrdersADABTCChannel
 	OrderBookADABTCChannel
 	DetailOrderBookADABTCChannel
 	DiffOrderBookADABTCChannel
+	MyOrdersADABTCPrivateChannel
+	MyTradesADABTCPrivateChannel
 	LiveTradesADAEURChannel
 	LiveOrdersADAEURChannel
 	OrderBookADAEURChannel
 	DetailOrderBookADAEURChannel
 	DiffOrderBookADAEURChannel
+	MyOrdersADAEURPrivateChannel
+	MyTradesADAEURPrivateChannel
 	LiveTradesADAUSDChannel
 	LiveOrdersADAUSDChannel
 	OrderBookADAUSDChannel
 	DetailOrderBookADAUSDChannel
 	DiffOrderBookADAUSDChannel
+	MyOrdersADAUSDPrivateChannel
+	MyTradesADAUSDPrivateChannel
 	LiveTradesALGOBTCChannel
 	LiveOrdersALGOBTCChannel
 	OrderBookALGOBTCChannel
 	DetailOrderBookALGOBTCChannel
 	DiffOrderBookALGOBTCChannel
+	MyOrdersALGOBTCPrivateChannel
+	MyTradesALGOBTCPrivateChannel
 	LiveTradesALGOEURChannel
 	LiveOrdersALGOEURChannel
 	OrderBookALGOEURChannel
 	DetailOrderBookALGOEURChannel
 	DiffOrderBookALGOEURChannel
+	MyOrdersALGOEURPrivateChannel
+	MyTradesALGOEURPrivateChannel
 	LiveTradesALGOUSDChannel
 	LiveOrdersALGOUSDChannel
 	OrderBookALGOUSDChannel
 	DetailOrderBookALGOUSDChannel
 	DiffOrderBookALGOUSDChannel
+	MyOrdersALGOUSDPrivateChannel
+	MyTradesALGOUSDPrivateChannel
 	LiveTradesALPHAEURChannel
 	LiveOrdersALPHAEURChannel
 	OrderBookALPHAEURChannel
 	DetailOrderBookALPHAEURChannel
 	DiffOrderBookALPHAEURChannel
+	MyOrdersALPHAEURPrivateChannel
+	MyTradesALPHAEURPrivateChannel
 	LiveTradesALPHAUSDChannel
 	LiveOrdersALPHAUSDChannel
 	OrderBookALPHAUSDChannel
 	DetailOrderBookALPHAUSDChannel
 	DiffOrderBookALPHAUSDChannel
+	MyOrdersALPHAUSDPrivateChannel
+	MyTradesALPHAUSDPrivateChannel
 	LiveTradesAMPEURChannel
 	LiveOrdersAMPEURChannel
 	OrderBookAMPEURChannel
 	DetailOrderBookAMPEURChannel
 	DiffOrderBookAMPEURChannel
+	MyOrdersAMPEURPrivateChannel
+	MyTradesAMPEURPrivateChannel
 	LiveTradesAMPUSDChannel
 	LiveOrdersAMPUSDChannel
 	OrderBookAMPUSDChannel
 	DetailOrderBookAMPUSDChannel
 	DiffOrderBookAMPUSDChannel
+	MyOrdersAMPUSDPrivateChannel
+	MyTradesAMPUSDPrivateChannel
 	LiveTradesANTEURChannel
 	LiveOrdersANTEURChannel
 	OrderBookANTEURChannel
 	DetailOrderBookANTEURChannel
 	DiffOrderBookANTEURChannel
+	MyOrdersANTEURPrivateChannel
+	MyTradesANTEURPrivateChannel
 	LiveTradesANTUSDChannel
 	LiveOrdersANTUSDChannel
 	OrderBookANTUSDChannel
 	DetailOrderBookANTUSDChannel
 	DiffOrderBookANTUSDChannel
+	MyOrdersANTUSDPrivateChannel
+	MyTradesANTUSDPrivateChannel
 	LiveTradesAUDIOBTCChannel
 	LiveOrdersAUDIOBTCChannel
 	OrderBookAUDIOBTCChannel
 	DetailOrderBookAUDIOBTCChannel
 	DiffOrderBookAUDIOBTCChannel
+	MyOrdersAUDIOBTCPrivateChannel
+	MyTradesAUDIOBTCPrivateChannel
 	LiveTradesAUDIOEURChannel
 	LiveOrdersAUDIOEURChannel
 	OrderBookAUDIOEURChannel
 	DetailOrderBookAUDIOEURChannel
 	DiffOrderBookAUDIOEURChannel
+	MyOrdersAUDIOEURPrivateChannel
+	MyTradesAUDIOEURPrivateChannel
 	LiveTradesAUDIOUSDChannel
 	LiveOrdersAUDIOUSDChannel
 	OrderBookAUDIOUSDChannel
 	DetailOrderBookAUDIOUSDChannel
 	DiffOrderBookAUDIOUSDChannel
+	MyOrdersAUDIOUSDPrivateChannel
+	MyTradesAUDIOUSDPrivateChannel
 	LiveTradesAVAXEURChannel
 	LiveOrdersAVAXEURChannel
 	OrderBookAVAXEURChannel
 	DetailOrderBookAVAXEURChannel
 	DiffOrderBookAVAXEURChannel
+	MyOrdersAVAXEURPrivateChannel
+	MyTradesAVAXEURPrivateChannel
 	LiveTradesAVAXUSDChannel
 	LiveOrdersAVAXUSDChannel
 	OrderBookAVAXUSDChannel
 	DetailOrderBookAVAXUSDChannel
 	DiffOrderBookAVAXUSDChannel
+	MyOrdersAVAXUSDPrivateChannel
+	MyTradesAVAXUSDPrivateChannel
 	LiveTradesAXSEURChannel
 	LiveOrdersAXSEURChannel
 	OrderBookAXSEURChannel
 	DetailOrderBookAXSEURChannel
 	DiffOrderBookAXSEURChannel
+	MyOrdersAXSEURPrivateChannel
+	MyTradesAXSEURPrivateChannel
 	LiveTradesAXSUSDChannel
 	LiveOrdersAXSUSDChannel
 	OrderBookAXSUSDChannel
 	DetailOrderBookAXSUSDChannel
 	DiffOrderBookAXSUSDChannel
+	MyOrdersAXSUSDPrivateChannel
+	MyTradesAXSUSDPrivateChannel
 	LiveTradesBANDEURChannel
 	LiveOrdersBANDEURChannel
 	OrderBookBANDEURChannel
 	DetailOrderBookBANDEURChannel
 	DiffOrderBookBANDEURChannel
+	MyOrdersBANDEURPrivateChannel
+	MyTradesBANDEURPrivateChannel
 	LiveTradesBANDUSDChannel
 	LiveOrdersBANDUSDChannel
 	OrderBookBANDUSDChannel
 	DetailOrderBookBANDUSDChannel
 	DiffOrderBookBANDUSDChannel
+	MyOrdersBANDUSDPrivateChannel
+	MyTradesBANDUSDPrivateChannel
 	LiveTradesBATBTCChannel
 	LiveOrdersBATBTCChannel
 	OrderBookBATBTCChannel
 	DetailOrderBookBATBTCChannel
 	DiffOrderBookBATBTCChannel
+	MyOrdersBATBTCPrivateChannel
+	MyTradesBATBTCPrivateChannel
 	LiveTradesBATEURChannel
 	LiveOrdersBATEURChannel
 	OrderBookBATEURChannel
 	DetailOrderBookBATEURChannel
 	DiffOrderBookBATEURChannel
+	MyOrdersBATEURPrivateChannel
+	MyTradesBATEURPrivateChannel
 	LiveTradesBATUSDChannel
 	LiveOrdersBATUSDChannel
 	OrderBookBATUSDChannel
 	DetailOrderBookBATUSDChannel
 	DiffOrderBookBATUSDChannel
+	MyOrdersBATUSDPrivateChannel
+	MyTradesBATUSDPrivateChannel
 	LiveTradesBCHBTCChannel
 	LiveOrdersBCHBTCChannel
 	OrderBookBCHBTCChannel
 	DetailOrderBookBCHBTCChannel
 	DiffOrderBookBCHBTCChannel
+	MyOrdersBCHBTCPrivateChannel
+	MyTradesBCHBTCPrivateChannel
 	LiveTradesBCHEURChannel
 	LiveOrdersBCHEURChannel
 	OrderBookBCHEURChannel
 	DetailOrderBookBCHEURChannel
 	DiffOrderBookBCHEURChannel
+	MyOrdersBCHEURPrivateChannel
+	MyTradesBCHEURPrivateChannel
 	LiveTradesBCHGBPChannel
 	LiveOrdersBCHGBPChannel
 	OrderBookBCHGBPChannel
 	DetailOrderBookBCHGBPChannel
 	DiffOrderBookBCHGBPChannel
+	MyOrdersBCHGBPPrivateChannel
+	MyTradesBCHGBPPrivateChannel
 	LiveTradesBCHUSDChannel
 	LiveOrdersBCHUSDChannel
 	OrderBookBCHUSDChannel
 	DetailOrderBookBCHUSDChannel
 	DiffOrderBookBCHUSDChannel
+	MyOrdersBCHUSDPrivateChannel
+	MyTradesBCHUSDPrivateChannel
 	LiveTradesBTCEURChannel
 	LiveOrdersBTCEURChannel
 	OrderBookBTCEURChannel
 	DetailOrderBookBTCEURChannel
 	DiffOrderBookBTCEURChannel
+	MyOrdersBTCEURPrivateChannel
+	MyTradesBTCEURPrivateChannel
 	LiveTradesBTCGBPChannel
 	LiveOrdersBTCGBPChannel
 	OrderBookBTCGBPChannel
 	DetailOrderBookBTCGBPChannel
 	DiffOrderBookBTCGBPChannel
+	MyOrdersBTCGBPPrivateChannel
+	MyTradesBTCGBPPrivateChannel
 	LiveTradesBTCPAXChannel
 	LiveOrdersBTCPAXChannel
 	OrderBookBTCPAXChannel
 	DetailOrderBookBTCPAXChannel
 	DiffOrderBookBTCPAXChannel
+	MyOrdersBTCPAXPrivateChannel
+	MyTradesBTCPAXPrivateChannel
 	LiveTradesBTCUSDChannel
 	LiveOrdersBTCUSDChannel
 	OrderBookBTCUSDChannel
 	DetailOrderBookBTCUSDChannel
 	DiffOrderBookBTCUSDChannel
+	MyOrdersBTCUSDPrivateChannel
+	MyTradesBTCUSDPrivateChannel
 	LiveTradesBTCUSDCChannel
 	LiveOrdersBTCUSDCChannel
 	OrderBookBTCUSDCChannel
 	DetailOrderBookBTCUSDCChannel
 	DiffOrderBookBTCUSDCChannel
+	MyOrdersBTCUSDCPrivateChannel
+	MyTradesBTCUSDCPrivateChannel
 	LiveTradesBTCUSDTChannel
 	LiveOrdersBTCUSDTChannel
 	OrderBookBTCUSDTChannel
 	DetailOrderBookBTCUSDTChannel
 	DiffOrderBookBTCUSDTChannel
+	MyOrdersBTCUSDTPrivateChannel
+	MyTradesBTCUSDTPrivateChannel
 	LiveTradesCELEURChannel
 	LiveOrdersCELEURChannel
 	OrderBookCELEURChannel
 	DetailOrderBookCELEURChannel
 	DiffOrderBookCELEURChannel
+	MyOrdersCELEURPrivateChannel
+	MyTradesCELEURPrivateChannel
 	LiveTradesCELUSDChannel
 	LiveOrdersCELUSDChannel
 	OrderBookCELUSDChannel
 	DetailOrderBookCELUSDChannel
 	DiffOrderBookCELUSDChannel
+	MyOrdersCELUSDPrivateChannel
+	MyTradesCELUSDPrivateChannel
 	LiveTradesCHZEURChannel
 	LiveOrdersCHZEURChannel
 	OrderBookCHZEURChannel
 	DetailOrderBookCHZEURChannel
 	DiffOrderBookCHZEURChannel
+	MyOrdersCHZEURPrivateChannel
+	MyTradesCHZEURPrivateChannel
 	LiveTradesCHZUSDChannel
 	LiveOrdersCHZUSDChannel
 	OrderBookCHZUSDChannel
 	DetailOrderBookCHZUSDChannel
 	DiffOrderBookCHZUSDChannel
+	MyOrdersCHZUSDPrivateChannel
+	MyTradesCHZUSDPrivateChannel
 	LiveTradesCOMPBTCChannel
 	LiveOrdersCOMPBTCChannel
 	OrderBookCOMPBTCChannel
 	DetailOrderBookCOMPBTCChannel
 	DiffOrderBookCOMPBTCChannel
+	MyOrdersCOMPBTCPrivateChannel
+	MyTradesCOMPBTCPrivateChannel
 	LiveTradesCOMPEURChannel
 	LiveOrdersCOMPEURChannel
 	OrderBookCOMPEURChannel
 	DetailOrderBookCOMPEURChannel
 	DiffOrderBookCOMPEURChannel
+	MyOrdersCOMPEURPrivateChannel
+	MyTradesCOMPEURPrivateChannel
 	LiveTradesCOMPUSDChannel
 	LiveOrdersCOMPUSDChannel
 	OrderBookCOMPUSDChannel
 	DetailOrderBookCOMPUSDChannel
 	DiffOrderBookCOMPUSDChannel
+	MyOrdersCOMPUSDPrivateChannel
+	MyTradesCOMPUSDPrivateChannel
 	LiveTradesCRVBTCChannel
 	LiveOrdersCRVBTCChannel
 	OrderBookCRVBTCChannel
 	DetailOrderBookCRVBTCChannel
 	DiffOrderBookCRVBTCChannel
+	MyOrdersCRVBTCPrivateChannel
+	MyTradesCRVBTCPrivateChannel
 	LiveTradesCRVEURChannel
 	LiveOrdersCRVEURChannel
 	OrderBookCRVEURChannel
 	DetailOrderBookCRVEURChannel
 	DiffOrderBookCRVEURChannel
+	MyOrdersCRVEURPrivateChannel
+	MyTradesCRVEURPrivateChannel
 	LiveTradesCRVUSDChannel
 	LiveOrdersCRVUSDChannel
 	OrderBookCRVUSDChannel
 	DetailOrderBookCRVUSDChannel
 	DiffOrderBookCRVUSDChannel
+	MyOrdersCRVUSDPrivateChannel
+	MyTradesCRVUSDPrivateChannel
 	LiveTradesCTSIEURChannel
 	LiveOrdersCTSIEURChannel
 	OrderBookCTSIEURChannel
 	DetailOrderBookCTSIEURChannel
 	DiffOrderBookCTSIEURChannel
+	MyOrdersCTSIEURPrivateChannel
+	MyTradesCTSIEURPrivateChannel
 	LiveTradesCTSIUSDChannel
 	LiveOrdersCTSIUSDChannel
 	OrderBookCTSIUSDChannel
 	DetailOrderBookCTSIUSDChannel
 	DiffOrderBookCTSIUSDChannel
+	MyOrdersCTSIUSDPrivateChannel
+	MyTradesCTSIUSDPrivateChannel
 	LiveTradesCVXEURChannel
 	LiveOrdersCVXEURChannel
 	OrderBookCVXEURChannel
 	DetailOrderBookCVXEURChannel
 	DiffOrderBookCVXEURChannel
+	MyOrdersCVXEURPrivateChannel
+	MyTradesCVXEURPrivateChannel
 	LiveTradesCVXUSDChannel
 	LiveOrdersCVXUSDChannel
 	OrderBookCVXUSDChannel
 	DetailOrderBookCVXUSDChannel
 	DiffOrderBookCVXUSDChannel
+	MyOrdersCVXUSDPrivateChannel
+	MyTradesCVXUSDPrivateChannel
 	LiveTradesDAIUSDChannel
 	LiveOrdersDAIUSDChannel
 	OrderBookDAIUSDChannel
 	DetailOrderBookDAIUSDChannel
 	DiffOrderBookDAIUSDChannel
+	MyOrdersDAIUSDPrivateChannel
+	MyTradesDAIUSDPrivateChannel
 	LiveTradesDYDXEURChannel
 	LiveOrdersDYDXEURChannel
 	OrderBookDYDXEURChannel
 	DetailOrderBookDYDXEURChannel
 	DiffOrderBookDYDXEURChannel
+	MyOrdersDYDXEURPrivateChannel
+	MyTradesDYDXEURPrivateChannel
 	LiveTradesDYDXUSDChannel
 	LiveOrdersDYDXUSDChannel
 	OrderBookDYDXUSDChannel
 	DetailOrderBookDYDXUSDChannel
 	DiffOrderBookDYDXUSDChannel
+	MyOrdersDYDXUSDPrivateChannel
+	MyTradesDYDXUSDPrivateChannel
 	LiveTradesENJEURChannel
 	LiveOrdersENJEURChannel
 	OrderBookENJEURChannel
 	DetailOrderBookENJEURChannel
 	DiffOrderBookENJEURChannel
+	MyOrdersENJEURPrivateChannel
+	MyTradesENJEURPrivateChannel
 	LiveTradesENJUSDChannel
 	LiveOrdersENJUSDChannel
 	OrderBookENJUSDChannel
 	DetailOrderBookENJUSDChannel
 	DiffOrderBookENJUSDChannel
+	MyOrdersENJUSDPrivateChannel
+	MyTradesENJUSDPrivateChannel
 	LiveTradesETH2ETHChannel
 	LiveOrdersETH2ETHChannel
 	OrderBookETH2ETHChannel
 	DetailOrderBookETH2ETHChannel
 	DiffOrderBookETH2ETHChannel
+	MyOrdersETH2ETHPrivateChannel
+	MyTradesETH2ETHPrivateChannel
 	LiveTradesETHBTCChannel
 	LiveOrdersETHBTCChannel
 	OrderBookETHBTCChannel
 	DetailOrderBookETHBTCChannel
 	DiffOrderBookETHBTCChannel
+	MyOrdersETHBTCPrivateChannel
+	MyTradesETHBTCPrivateChannel
 	LiveTradesETHEURChannel
 	LiveOrdersETHEURChannel
 	OrderBookETHEURChannel
 	DetailOrderBookETHEURChannel
 	DiffOrderBookETHEURChannel
+	MyOrdersETHEURPrivateChannel
+	MyTradesETHEURPrivateChannel
 	LiveTradesETHGBPChannel
 	LiveOrdersETHGBPChannel
 	OrderBookETHGBPChannel
 	DetailOrderBookETHGBPChannel
 	DiffOrderBookETHGBPChannel
+	MyOrdersETHGBPPrivateChannel
+	MyTradesETHGBPPrivateChannel
 	LiveTradesETHPAXChannel
 	LiveOrdersETHPAXChannel
 	OrderBookETHPAXChannel
 	DetailOrderBookETHPAXChannel
 	DiffOrderBookETHPAXChannel
+	MyOrdersETHPAXPrivateChannel
+	MyTradesETHPAXPrivateChannel
 	LiveTradesETHUSDChannel
 	LiveOrdersETHUSDChannel
 	OrderBookETHUSDChannel
 	DetailOrderBookETHUSDChannel
 	DiffOrderBookETHUSDChannel
+	MyOrdersETHUSDPrivateChannel
+	MyTradesETHUSDPrivateChannel
 	LiveTradesETHUSDCChannel
 	LiveOrdersETHUSDCChannel
 	OrderBookETHUSDCChannel
 	DetailOrderBookETHUSDCChannel
 	DiffOrderBookETHUSDCChannel
+	MyOrdersETHUSDCPrivateChannel
+	MyTradesETHUSDCPrivateChannel
 	LiveTradesETHUSDTChannel
 	LiveOrdersETHUSDTChannel
 	OrderBookETHUSDTChannel
 	DetailOrderBookETHUSDTChannel
 	DiffOrderBookETHUSDTChannel
+	MyOrdersETHUSDTPrivateChannel
+	MyTradesETHUSDTPrivateChannel
 	LiveTradesEURTEURChannel
 	LiveOrdersEURTEURChannel
 	OrderBookEURTEURChannel
 	DetailOrderBookEURTEURChannel
 	DiffOrderBookEURTEURChannel
+	MyOrdersEURTEURPrivateChannel
+	MyTradesEURTEURPrivateChannel
 	LiveTradesEURTUSDChannel
 	LiveOrdersEURTUSDChannel
 	OrderBookEURTUSDChannel
 	DetailOrderBookEURTUSDChannel
 	DiffOrderBookEURTUSDChannel
+	MyOrdersEURTUSDPrivateChannel
+	MyTradesEURTUSDPrivateChannel
 	LiveTradesEURUSDChannel
 	LiveOrdersEURUSDChannel
 	OrderBookEURUSDChannel
 	DetailOrderBookEURUSDChannel
 	DiffOrderBookEURUSDChannel
+	MyOrdersEURUSDPrivateChannel
+	MyTradesEURUSDPrivateChannel
 	LiveTradesFETEURChannel
 	LiveOrdersFETEURChannel
 	OrderBookFETEURChannel
 	DetailOrderBookFETEURChannel
 	DiffOrderBookFETEURChannel
+	MyOrdersFETEURPrivateChannel
+	MyTradesFETEURPrivateChannel
 	LiveTradesFETUSDChannel
 	LiveOrdersFETUSDChannel
 	OrderBookFETUSDChannel
 	DetailOrderBookFETUSDChannel
 	DiffOrderBookFETUSDChannel
+	MyOrdersFETUSDPrivateChannel
+	MyTradesFETUSDPrivateChannel
 	LiveTradesFTMEURChannel
 	LiveOrdersFTMEURChannel
 	OrderBookFTMEURChannel
 	DetailOrderBookFTMEURChannel
 	DiffOrderBookFTMEURChannel
+	MyOrdersFTMEURPrivateChannel
+	MyTradesFTMEURPrivateChannel
 	LiveTradesFTMUSDChannel
 	LiveOrdersFTMUSDChannel
 	OrderBookFTMUSDChannel
 	DetailOrderBookFTMUSDChannel
 	DiffOrderBookFTMUSDChannel
+	MyOrdersFTMUSDPrivateChannel
+	MyTradesFTMUSDPrivateChannel
 	LiveTradesFTTEURChannel
 	LiveOrdersFTTEURChannel
 	OrderBookFTTEURChannel
 	DetailOrderBookFTTEURChannel
 	DiffOrderBookFTTEURChannel
+	MyOrdersFTTEURPrivateChannel
+	MyTradesFTTEURPrivateChannel
 	LiveTradesFTTUSDChannel
 	LiveOrdersFTTUSDChannel
 	OrderBookFTTUSDChannel
 	DetailOrderBookFTTUSDChannel
 	DiffOrderBookFTTUSDChannel
+	MyOrdersFTTUSDPrivateChannel
+	MyTradesFTTUSDPrivateChannel
 	LiveTradesGALAEURChannel
 	LiveOrdersGALAEURChannel
 	OrderBookGALAEURChannel
 	DetailOrderBookGALAEURChannel
 	DiffOrderBookGALAEURChannel
+	MyOrdersGALAEURPrivateChannel
+	MyTradesGALAEURPrivateChannel
 	LiveTradesGALAUSDChannel
 	LiveOrdersGALAUSDChannel
 	OrderBookGALAUSDChannel
 	DetailOrderBookGALAUSDChannel
 	DiffOrderBookGALAUSDChannel
+	MyOrdersGALAUSDPrivateChannel
+	MyTradesGALAUSDPrivateChannel
 	LiveTradesGBPEURChannel
 	LiveOrdersGBPEURChannel
 	OrderBookGBPEURChannel
 	DetailOrderBookGBPEURChannel
 	DiffOrderBookGBPEURChannel
+	MyOrdersGBPEURPrivateChannel
+	MyTradesGBPEURPrivateChannel
 	LiveTradesGBPUSDChannel
 	LiveOrdersGBPUSDChannel
 	OrderBookGBPUSDChannel
 	DetailOrderBookGBPUSDChannel
 	DiffOrderBookGBPUSDChannel
+	MyOrdersGBPUSDPrivateChannel
+	MyTradesGBPUSDPrivateChannel
 	LiveTradesGODSEURChannel
 	LiveOrdersGODSEURChannel
 	OrderBookGODSEURChannel
 	DetailOrderBookGODSEURChannel
 	DiffOrderBookGODSEURChannel
+	MyOrdersGODSEURPrivateChannel
+	MyTradesGODSEURPrivateChannel
 	LiveTradesGODSUSDChannel
 	LiveOrdersGODSUSDChannel
 	OrderBookGODSUSDChannel
 	DetailOrderBookGODSUSDChannel
 	DiffOrderBookGODSUSDChannel
+	MyOrdersGODSUSDPrivateChannel
+	MyTradesGODSUSDPrivateChannel
 	LiveTradesGRTEURChannel
 	LiveOrdersGRTEURChannel
 	OrderBookGRTEURChannel
 	DetailOrderBookGRTEURChannel
 	DiffOrderBookGRTEURChannel
+	MyOrdersGRTEURPrivateChannel
+	MyTradesGRTEURPrivateChannel
 	LiveTradesGRTUSDChannel
 	LiveOrdersGRTUSDChannel
 	OrderBookGRTUSDChannel
 	DetailOrderBookGRTUSDChannel
 	DiffOrderBookGRTUSDChannel
+	MyOrdersGRTUSDPrivateChannel
+	MyTradesGRTUSDPrivateChannel
 	LiveTradesGUSDUSDChannel
 	LiveOrdersGUSDUSDChannel
 	OrderBookGUSDUSDChannel
 	DetailOrderBookGUSDUSDChannel
 	DiffOrderBookGUSDUSDChannel
+	MyOrdersGUSDUSDPrivateChannel
+	MyTradesGUSDUSDPrivateChannel
 	LiveTradesHBAREURChannel
 	LiveOrdersHBAREURChannel
 	OrderBookHBAREURChannel
 	DetailOrderBookHBAREURChannel
 	DiffOrderBookHBAREURChannel
+	MyOrdersHBAREURPrivateChannel
+	MyTradesHBAREURPrivateChannel
 	LiveTradesHBARUSDChannel
 	LiveOrdersHBARUSDChannel
 	OrderBookHBARUSDChannel
 	DetailOrderBookHBARUSDChannel
 	DiffOrderBookHBARUSDChannel
+	MyOrdersHBARUSDPrivateChannel
+	MyTradesHBARUSDPrivateChannel
 	LiveTradesIMXEURChannel
 	LiveOrdersIMXEURChannel
 	OrderBookIMXEURChannel
 	DetailOrderBookIMXEURChannel
 	DiffOrderBookIMXEURChannel
+	MyOrdersIMXEURPrivateChannel
+	MyTradesIMXEURPrivateChannel
 	LiveTradesIMXUSDChannel
 	LiveOrdersIMXUSDChannel
 	OrderBookIMXUSDChannel
 	DetailOrderBookIMXUSDChannel
 	DiffOrderBookIMXUSDChannel
+	MyOrdersIMXUSDPrivateChannel
+	MyTradesIMXUSDPrivateChannel
 	LiveTradesINJEURChannel
 	LiveOrdersINJEURChannel
 	OrderBookINJEURChannel
 	DetailOrderBookINJEURChannel
 	DiffOrderBookINJEURChannel
+	MyOrdersINJEURPrivateChannel
+	MyTradesINJEURPrivateChannel
 	LiveTradesINJUSDChannel
 	LiveOrdersINJUSDChannel
 	OrderBookINJUSDChannel
 	DetailOrderBookINJUSDChannel
 	DiffOrderBookINJUSDChannel
+	MyOrdersINJUSDPrivateChannel
+	MyTradesINJUSDPrivateChannel
 	LiveTradesKNCBTCChannel
 	LiveOrdersKNCBTCChannel
 	OrderBookKNCBTCChannel
 	DetailOrderBookKNCBTCChannel
 	DiffOrderBookKNCBTCChannel
+	MyOrdersKNCBTCPrivateChannel
+	MyTradesKNCBTCPrivateChannel
 	LiveTradesKNCEURChannel
 	LiveOrdersKNCEURChannel
 	OrderBookKNCEURChannel
 	DetailOrderBookKNCEURChannel
 	DiffOrderBookKNCEURChannel
+	MyOrdersKNCEURPrivateChannel
+	MyTradesKNCEURPrivateChannel
 	LiveTradesKNCUSDChannel
 	LiveOrdersKNCUSDChannel
 	OrderBookKNCUSDChannel
 	DetailOrderBookKNCUSDChannel
 	DiffOrderBookKNCUSDChannel
+	MyOrdersKNCUSDPrivateChannel
+	MyTradesKNCUSDPrivateChannel
 	LiveTradesLINKBTCChannel
 	LiveOrdersLINKBTCChannel
 	OrderBookLINKBTCChannel
 	DetailOrderBookLINKBTCChannel
 	DiffOrderBookLINKBTCChannel
+	MyOrdersLINKBTCPrivateChannel
+	MyTradesLINKBTCPrivateChannel
 	LiveTradesLINKETHChannel
 	LiveOrdersLINKETHChannel
 	OrderBookLINKETHChannel
 	DetailOrderBookLINKETHChannel
 	DiffOrderBookLINKETHChannel
+	MyOrdersLINKETHPrivateChannel
+	MyTradesLINKETHPrivateChannel
 	LiveTradesLINKEURChannel
 	LiveOrdersLINKEURChannel
 	OrderBookLINKEURChannel
 	DetailOrderBookLINKEURChannel
 	DiffOrderBookLINKEURChannel
+	MyOrdersLINKEURPrivateChannel
+	MyTradesLINKEURPrivateChannel
 	LiveTradesLINKGBPChannel
 	LiveOrdersLINKGBPChannel
 	OrderBookLINKGBPChannel
 	DetailOrderBookLINKGBPChannel
 	DiffOrderBookLINKGBPChannel
+	MyOrdersLINKGBPPrivateChannel
+	MyTradesLINKGBPPrivateChannel
 	LiveTradesLINKUSDChannel
 	LiveOrdersLINKUSDChannel
 	OrderBookLINKUSDChannel
 	DetailOrderBookLINKUSDChannel
 	DiffOrderBookLINKUSDChannel
+	MyOrdersLINKUSDPrivateChannel
+	MyTradesLINKUSDPrivateChannel
 	LiveTradesLTCBTCChannel
 	LiveOrdersLTCBTCChannel
 	OrderBookLTCBTCChannel
 	DetailOrderBookLTCBTCChannel
 	DiffOrderBookLTCBTCChannel
+	MyOrdersLTCBTCPrivateChannel
+	MyTradesLTCBTCPrivateChannel
 	LiveTradesLTCEURChannel
 	LiveOrdersLTCEURChannel
 	OrderBookLTCEURChannel
 	DetailOrderBookLTCEURChannel
 	DiffOrderBookLTCEURChannel
+	MyOrdersLTCEURPrivateChannel
+	MyTradesLTCEURPrivateChannel
 	LiveTradesLTCGBPChannel
 	LiveOrdersLTCGBPChannel
 	OrderBookLTCGBPChannel
 	DetailOrderBookLTCGBPChannel
 	DiffOrderBookLTCGBPChannel
+	MyOrdersLTCGBPPrivateChannel
+	MyTradesLTCGBPPrivateChannel
 	LiveTradesLTCUSDChannel
 	LiveOrdersLTCUSDChannel
 	OrderBookLTCUSDChannel
 	DetailOrderBookLTCUSDChannel
 	DiffOrderBookLTCUSDChannel
+	MyOrdersLTCUSDPrivateChannel
+	MyTradesLTCUSDPrivateChannel
 	LiveTradesMATICEURChannel
 	LiveOrdersMATICEURChannel
 	OrderBookMATICEURChannel
 	DetailOrderBookMATICEURChannel
 	DiffOrderBookMATICEURChannel
+	MyOrdersMATICEURPrivateChannel
+	MyTradesMATICEURPrivateChannel
 	LiveTradesMATICUSDChannel
 	LiveOrdersMATICUSDChannel
 	OrderBookMATICUSDChannel
 	DetailOrderBookMATICUSDChannel
 	DiffOrderBookMATICUSDChannel
+	MyOrdersMATICUSDPrivateChannel
+	MyTradesMATICUSDPrivateChannel
 	LiveTradesMKRBTCChannel
 	LiveOrdersMKRBTCChannel
 	OrderBookMKRBTCChannel
 	DetailOrderBookMKRBTCChannel
 	DiffOrderBookMKRBTCChannel
+	MyOrdersMKRBTCPrivateChannel
+	MyTradesMKRBTCPrivateChannel
 	LiveTradesMKREURChannel
 	LiveOrdersMKREURChannel
 	OrderBookMKREURChannel
 	DetailOrderBookMKREURChannel
 	DiffOrderBookMKREURChannel
+	MyOrdersMKREURPrivateChannel
+	MyTradesMKREURPrivateChannel
 	LiveTradesMKRUSDChannel
 	LiveOrdersMKRUSDChannel
 	OrderBookMKRUSDChannel
 	DetailOrderBookMKRUSDChannel
 	DiffOrderBookMKRUSDChannel
+	MyOrdersMKRUSDPrivateChannel
+	MyTradesMKRUSDPrivateChannel
 	LiveTradesNEXOEURChannel
 	LiveOrdersNEXOEURChannel
 	OrderBookNEXOEURChannel
 	DetailOrderBookNEXOEURChannel
 	DiffOrderBookNEXOEURChannel
+	MyOrdersNEXOEURPrivateChannel
+	MyTradesNEXOEURPrivateChannel
 	LiveTradesNEXOUSDChannel
 	LiveOrdersNEXOUSDChannel
 	OrderBookNEXOUSDChannel
 	DetailOrderBookNEXOUSDChannel
 	DiffOrderBookNEXOUSDChannel
+	MyOrdersNEXOUSDPrivateChannel
+	MyTradesNEXOUSDPrivateChannel
 	LiveTradesOMGBTCChannel
 	LiveOrdersOMGBTCChannel
 	OrderBookOMGBTCChannel
 	DetailOrderBookOMGBTCChannel
 	DiffOrderBookOMGBTCChannel
+	MyOrdersOMGBTCPrivateChannel
+	MyTradesOMGBTCPrivateChannel
 	LiveTradesOMGEURChannel
 	LiveOrdersOMGEURChannel
 	OrderBookOMGEURChannel
 	DetailOrderBookOMGEURChannel
 	DiffOrderBookOMGEURChannel
+	MyOrdersOMGEURPrivateChannel
+	MyTradesOMGEURPrivateChannel
 	LiveTradesOMGGBPChannel
 	LiveOrdersOMGGBPChannel
 	OrderBookOMGGBPChannel
 	DetailOrderBookOMGGBPChannel
 	DiffOrderBookOMGGBPChannel
+	MyOrdersOMGGBPPrivateChannel
+	MyTradesOMGGBPPrivateChannel
 	LiveTradesOMGUSDChannel
 	LiveOrdersOMGUSDChannel
 	OrderBookOMGUSDChannel
 	DetailOrderBookOMGUSDChannel
 	DiffOrderBookOMGUSDChannel
+	MyOrdersOMGUSDPrivateChannel
+	MyTradesOMGUSDPrivateChannel
 	LiveTradesPAXEURChannel
 	LiveOrdersPAXEURChannel
 	OrderBookPAXEURChannel
 	DetailOrderBookPAXEURChannel
 	DiffOrderBookPAXEURChannel
+	MyOrdersPAXEURPrivateChannel
+	MyTradesPAXEURPrivateChannel
 	LiveTradesPAXGBPChannel
 	LiveOrdersPAXGBPChannel
 	OrderBookPAXGBPChannel
 	DetailOrderBookPAXGBPChannel
 	DiffOrderBookPAXGBPChannel
+	MyOrdersPAXGBPPrivateChannel
+	MyTradesPAXGBPPrivateChannel
 	LiveTradesPAXUSDChannel
 	LiveOrdersPAXUSDChannel
 	OrderBookPAXUSDChannel
 	DetailOrderBookPAXUSDChannel
 	DiffOrderBookPAXUSDChannel
+	MyOrdersPAXUSDPrivateChannel
+	MyTradesPAXUSDPrivateChannel
 	LiveTradesPERPEURChannel
 	LiveOrdersPERPEURChannel
 	OrderBookPERPEURChannel
 	DetailOrderBookPERPEURChannel
 	DiffOrderBookPERPEURChannel
+	MyOrdersPERPEURPrivateChannel
+	MyTradesPERPEURPrivateChannel
 	LiveTradesPERPUSDChannel
 	LiveOrdersPERPUSDChannel
 	OrderBookPERPUSDChannel
 	DetailOrderBookPERPUSDChannel
 	DiffOrderBookPERPUSDChannel
+	MyOrdersPERPUSDPrivateChannel
+	MyTradesPERPUSDPrivateChannel
 	LiveTradesRADEURChannel
 	LiveOrdersRADEURChannel
 	OrderBookRADEURChannel
 	DetailOrderBookRADEURChannel
 	DiffOrderBookRADEURChannel
+	MyOrdersRADEURPrivateChannel
+	MyTradesRADEURPrivateChannel
 	LiveTradesRADUSDChannel
 	LiveOrdersRADUSDChannel
 	OrderBookRADUSDChannel
 	DetailOrderBookRADUSDChannel
 	DiffOrderBookRADUSDChannel
+	MyOrdersRADUSDPrivateChannel
+	MyTradesRADUSDPrivateChannel
 	LiveTradesRGTEURChannel
 	LiveOrdersRGTEURChannel
 	OrderBookRGTEURChannel
 	DetailOrderBookRGTEURChannel
 	DiffOrderBookRGTEURChannel
+	MyOrdersRGTEURPrivateChannel
+	MyTradesRGTEURPrivateChannel
 	LiveTradesRGTUSDChannel
 	LiveOrdersRGTUSDChannel
 	OrderBookRGTUSDChannel
 	DetailOrderBookRGTUSDChannel
 	DiffOrderBookRGTUSDChannel
+	MyOrdersRGTUSDPrivateChannel
+	MyTradesRGTUSDPrivateChannel
 	LiveTradesRLYEURChannel
 	LiveOrdersRLYEURChannel
 	OrderBookRLYEURChannel
 	DetailOrderBookRLYEURChannel
 	DiffOrderBookRLYEURChannel
+	MyOrdersRLYEURPrivateChannel
+	MyTradesRLYEURPrivateChannel
 	LiveTradesRLYUSDChannel
 	LiveOrdersRLYUSDChannel
 	OrderBookRLYUSDChannel
 	DetailOrderBookRLYUSDChannel
 	DiffOrderBookRLYUSDChannel
+	MyOrdersRLYUSDPrivateChannel
+	MyTradesRLYUSDPrivateChannel
 	LiveTradesRNDREURChannel
 	LiveOrdersRNDREURChannel
 	OrderBookRNDREURChannel
 	DetailOrderBookRNDREURChannel
 	DiffOrderBookRNDREURChannel
+	MyOrdersRNDREURPrivateChannel
+	MyTradesRNDREURPrivateChannel
 	LiveTradesRNDRUSDChannel
 	LiveOrdersRNDRUSDChannel
 	OrderBookRNDRUSDChannel
 	DetailOrderBookRNDRUSDChannel
 	DiffOrderBookRNDRUSDChannel
+	MyOrdersRNDRUSDPrivateChannel
+	MyTradesRNDRUSDPrivateChannel
 	LiveTradesSANDEURChannel
 	LiveOrdersSANDEURChannel
 	OrderBookSANDEURChannel
 	DetailOrderBookSANDEURChannel
 	DiffOrderBookSANDEURChannel
+	MyOrdersSANDEURPrivateChannel
+	MyTradesSANDEURPrivateChannel
 	LiveTradesSANDUSDChannel
 	LiveOrdersSANDUSDChannel
 	OrderBookSANDUSDChannel
 	DetailOrderBookSANDUSDChannel
 	DiffOrderBookSANDUSDChannel
+	MyOrdersSANDUSDPrivateChannel
+	MyTradesSANDUSDPrivateChannel
 	LiveTradesSGBEURChannel
 	LiveOrdersSGBEURChannel
 	OrderBookSGBEURChannel
 	DetailOrderBookSGBEURChannel
 	DiffOrderBookSGBEURChannel
+	MyOrdersSGBEURPrivateChannel
+	MyTradesSGBEURPrivateChannel
 	LiveTradesSGBUSDChannel
 	LiveOrdersSGBUSDChannel
 	OrderBookSGBUSDChannel
 	DetailOrderBookSGBUSDChannel
 	DiffOrderBookSGBUSDChannel
+	MyOrdersSGBUSDPrivateChannel
+	MyTradesSGBUSDPrivateChannel
 	LiveTradesSKLEURChannel
 	LiveOrdersSKLEURChannel
 	OrderBookSKLEURChannel
 	DetailOrderBookSKLEURChannel
 	DiffOrderBookSKLEURChannel
+	MyOrdersSKLEURPrivateChannel
+	MyTradesSKLEURPrivateChannel
 	LiveTradesSKLUSDChannel
 	LiveOrdersSKLUSDChannel
 	OrderBookSKLUSDChannel
 	DetailOrderBookSKLUSDChannel
 	DiffOrderBookSKLUSDChannel
+	MyOrdersSKLUSDPrivateChannel
+	MyTradesSKLUSDPrivateChannel
 	LiveTradesSLPEURChannel
 	LiveOrdersSLPEURChannel
 	OrderBookSLPEURChannel
 	DetailOrderBookSLPEURChannel
 	DiffOrderBookSLPEURChannel
+	MyOrdersSLPEURPrivateChannel
+	MyTradesSLPEURPrivateChannel
 	LiveTradesSLPUSDChannel
 	LiveOrdersSLPUSDChannel
 	OrderBookSLPUSDChannel
 	DetailOrderBookSLPUSDChannel
 	DiffOrderBookSLPUSDChannel
+	MyOrdersSLPUSDPrivateChannel
+	MyTradesSLPUSDPrivateChannel
 	LiveTradesSNXBTCChannel
 	LiveOrdersSNXBTCChannel
 	OrderBookSNXBTCChannel
 	DetailOrderBookSNXBTCChannel
 	DiffOrderBookSNXBTCChannel
+	MyOrdersSNXBTCPrivateChannel
+	MyTradesSNXBTCPrivateChannel
 	LiveTradesSNXEURChannel
 	LiveOrdersSNXEURChannel
 	OrderBookSNXEURChannel
 	DetailOrderBookSNXEURChannel
 	DiffOrderBookSNXEURChannel
+	MyOrdersSNXEURPrivateChannel
+	MyTradesSNXEURPrivateChannel
 	LiveTradesSNXUSDChannel
 	LiveOrdersSNXUSDChannel
 	OrderBookSNXUSDChannel
 	DetailOrderBookSNXUSDChannel
 	DiffOrderBookSNXUSDChannel
+	MyOrdersSNXUSDPrivateChannel
+	MyTradesSNXUSDPrivateChannel
 	LiveTradesSTORJEURChannel
 	LiveOrdersSTORJEURChannel
 	OrderBookSTORJEURChannel
 	DetailOrderBookSTORJEURChannel
 	DiffOrderBookSTORJEURChannel
+	MyOrdersSTORJEURPrivateChannel
+	MyTradesSTORJEURPrivateChannel
 	LiveTradesSTORJUSDChannel
 	LiveOrdersSTORJUSDChannel
 	OrderBookSTORJUSDChannel
 	DetailOrderBookSTORJUSDChannel
 	DiffOrderBookSTORJUSDChannel
+	MyOrdersSTORJUSDPrivateChannel
+	MyTradesSTORJUSDPrivateChannel
 	LiveTradesSUSHIEURChannel
 	LiveOrdersSUSHIEURChannel
 	OrderBookSUSHIEURChannel
 	DetailOrderBookSUSHIEURChannel
 	DiffOrderBookSUSHIEURChannel
+	MyOrdersSUSHIEURPrivateChannel
+	MyTradesSUSHIEURPrivateChannel
 	LiveTradesSUSHIUSDChannel
 	LiveOrdersSUSHIUSDChannel
 	OrderBookSUSHIUSDChannel
 	DetailOrderBookSUSHIUSDChannel
 	DiffOrderBookSUSHIUSDChannel
+	MyOrdersSUSHIUSDPrivateChannel
+	MyTradesSUSHIUSDPrivateChannel
 	LiveTradesSXPEURChannel
 	LiveOrdersSXPEURChannel
 	OrderBookSXPEURChannel
 	DetailOrderBookSXPEURChannel
 	DiffOrderBookSXPEURChannel
+	MyOrdersSXPEURPrivateChannel
+	MyTradesSXPEURPrivateChannel
 	LiveTradesSXPUSDChannel
 	LiveOrdersSXPUSDChannel
 	OrderBookSXPUSDChannel
 	DetailOrderBookSXPUSDChannel
 	DiffOrderBookSXPUSDChannel
+	MyOrdersSXPUSDPrivateChannel
+	MyTradesSXPUSDPrivateChannel
 	LiveTradesUMABTCChannel
 	LiveOrdersUMABTCChannel
 	OrderBookUMABTCChannel
 	DetailOrderBookUMABTCChannel
 	DiffOrderBookUMABTCChannel
+	MyOrdersUMABTCPrivateChannel
+	MyTradesUMABTCPrivateChannel
 	LiveTradesUMAEURChannel
 	LiveOrdersUMAEURChannel
 	OrderBookUMAEURChannel
 	DetailOrderBookUMAEURChannel
 	DiffOrderBookUMAEURChannel
+	MyOrdersUMAEURPrivateChannel
+	MyTradesUMAEURPrivateChannel
 	LiveTradesUMAUSDChannel
 	LiveOrdersUMAUSDChannel
 	OrderBookUMAUSDChannel
 	DetailOrderBookUMAUSDChannel
 	DiffOrderBookUMAUSDChannel
+	MyOrdersUMAUSDPrivateChannel
+	MyTradesUMAUSDPrivateChannel
 	LiveTradesUNIBTCChannel
 	LiveOrdersUNIBTCChannel
 	OrderBookUNIBTCChannel
 	DetailOrderBookUNIBTCChannel
 	DiffOrderBookUNIBTCChannel
+	MyOrdersUNIBTCPrivateChannel
+	MyTradesUNIBTCPrivateChannel
 	LiveTradesUNIEURChannel
 	LiveOrdersUNIEURChannel
 	OrderBookUNIEURChannel
 	DetailOrderBookUNIEURChannel
 	DiffOrderBookUNIEURChannel
+	MyOrdersUNIEURPrivateChannel
+	MyTradesUNIEURPrivateChannel
 	LiveTradesUNIUSDChannel
 	LiveOrdersUNIUSDChannel
 	OrderBookUNIUSDChannel
 	DetailOrderBookUNIUSDChannel
 	DiffOrderBookUNIUSDChannel
+	MyOrdersUNIUSDPrivateChannel
+	MyTradesUNIUSDPrivateChannel
 	LiveTradesUSDCEURChannel
 	LiveOrdersUSDCEURChannel
 	OrderBookUSDCEURChannel
 	DetailOrderBookUSDCEURChannel
 	DiffOrderBookUSDCEURChannel
+	MyOrdersUSDCEURPrivateChannel
+	MyTradesUSDCEURPrivateChannel
 	LiveTradesUSDCUSDChannel
 	LiveOrdersUSDCUSDChannel
 	OrderBookUSDCUSDChannel
 	DetailOrderBookUSDCUSDChannel
 	DiffOrderBookUSDCUSDChannel
+	MyOrdersUSDCUSDPrivateChannel
+	MyTradesUSDCUSDPrivateChannel
 	LiveTradesUSDCUSDTChannel
 	LiveOrdersUSDCUSDTChannel
 	OrderBookUSDCUSDTChannel
 	DetailOrderBookUSDCUSDTChannel
 	DiffOrderBookUSDCUSDTChannel
+	MyOrdersUSDCUSDTPrivateChannel
+	MyTradesUSDCUSDTPrivateChannel
 	LiveTradesUSDTEURChannel
 	LiveOrdersUSDTEURChannel
 	OrderBookUSDTEURChannel
 	DetailOrderBookUSDTEURChannel
 	DiffOrderBookUSDTEURChannel
+	MyOrdersUSDTEURPrivateChannel
+	MyTradesUSDTEURPrivateChannel
 	LiveTradesUSDTUSDChannel
 	LiveOrdersUSDTUSDChannel
 	OrderBookUSDTUSDChannel
 	DetailOrderBookUSDTUSDChannel
 	DiffOrderBookUSDTUSDChannel
+	MyOrdersUSDTUSDPrivateChannel
+	MyTradesUSDTUSDPrivateChannel
 	LiveTradesUSTEURChannel
 	LiveOrdersUSTEURChannel
 	OrderBookUSTEURChannel
 	DetailOrderBookUSTEURChannel
 	DiffOrderBookUSTEURChannel
+	MyOrdersUSTEURPrivateChannel
+	MyTradesUSTEURPrivateChannel
 	LiveTradesUSTUSDChannel
 	LiveOrdersUSTUSDChannel
 	OrderBookUSTUSDChannel
 	DetailOrderBookUSTUSDChannel
 	DiffOrderBookUSTUSDChannel
+	MyOrdersUSTUSDPrivateChannel
+	MyTradesUSTUSDPrivateChannel
 	LiveTradesVEGAEURChannel
 	LiveOrdersVEGAEURChannel
 	OrderBookVEGAEURChannel
 	DetailOrderBookVEGAEURChannel
 	DiffOrderBookVEGAEURChannel
+	MyOrdersVEGAEURPrivateChannel
+	MyTradesVEGAEURPrivateChannel
 	LiveTradesVEGAUSDChannel
 	LiveOrdersVEGAUSDChannel
 	OrderBookVEGAUSDChannel
 	DetailOrderBookVEGAUSDChannel
 	DiffOrderBookVEGAUSDChannel
+	MyOrdersVEGAUSDPrivateChannel
+	MyTradesVEGAUSDPrivateChannel
 	LiveTradesWBTCBTCChannel
 	LiveOrdersWBTCBTCChannel
 	OrderBookWBTCBTCChannel
 	DetailOrderBookWBTCBTCChannel
 	DiffOrderBookWBTCBTCChannel
+	MyOrdersWBTCBTCPrivateChannel
+	MyTradesWBTCBTCPrivateChannel
 	LiveTradesXLMBTCChannel
 	LiveOrdersXLMBTCChannel
 	OrderBookXLMBTCChannel
 	DetailOrderBookXLMBTCChannel
 	DiffOrderBookXLMBTCChannel
+	MyOrdersXLMBTCPrivateChannel
+	MyTradesXLMBTCPrivateChannel
 	LiveTradesXLMEURChannel
 	LiveOrdersXLMEURChannel
 	OrderBookXLMEURChannel
 	DetailOrderBookXLMEURChannel
 	DiffOrderBookXLMEURChannel
+	MyOrdersXLMEURPrivateChannel
+	MyTradesXLMEURPrivateChannel
 	LiveTradesXLMGBPChannel
 	LiveOrdersXLMGBPChannel
 	OrderBookXLMGBPChannel
 	DetailOrderBookXLMGBPChannel
 	DiffOrderBookXLMGBPChannel
+	MyOrdersXLMGBPPrivateChannel
+	MyTradesXLMGBPPrivateChannel
 	LiveTradesXLMUSDChannel
 	LiveOrdersXLMUSDChannel
 	OrderBookXLMUSDChannel
 	DetailOrderBookXLMUSDChannel
 	DiffOrderBookXLMUSDChannel
+	MyOrdersXLMUSDPrivateChannel
+	MyTradesXLMUSDPrivateChannel
 	LiveTradesXRPBTCChannel
 	LiveOrdersXRPBTCChannel
 	OrderBookXRPBTCChannel
 	DetailOrderBookXRPBTCChannel
 	DiffOrderBookXRPBTCChannel
+	MyOrdersXRPBTCPrivateChannel
+	MyTradesXRPBTCPrivateChannel
 	LiveTradesXRPEURChannel
 	LiveOrdersXRPEURChannel
 	OrderBookXRPEURChannel
 	DetailOrderBookXRPEURChannel
 	DiffOrderBookXRPEURChannel
+	MyOrdersXRPEURPrivateChannel
+	MyTradesXRPEURPrivateChannel
 	LiveTradesXRPGBPChannel
 	LiveOrdersXRPGBPChannel
 	OrderBookXRPGBPChannel
 	DetailOrderBookXRPGBPChannel
 	DiffOrderBookXRPGBPChannel
+	MyOrdersXRPGBPPrivateChannel
+	MyTradesXRPGBPPrivateChannel
 	LiveTradesXRPPAXChannel
 	LiveOrdersXRPPAXChannel
 	OrderBookXRPPAXChannel
 	DetailOrderBookXRPPAXChannel
 	DiffOrderBookXRPPAXChannel
+	MyOrdersXRPPAXPrivateChannel
+	MyTradesXRPPAXPrivateChannel
 	LiveTradesXRPUSDChannel
 	LiveOrdersXRPUSDChannel
 	OrderBookXRPUSDChannel
 	DetailOrderBookXRPUSDChannel
 	DiffOrderBookXRPUSDChannel
+	MyOrdersXRPUSDPrivateChannel
+	MyTradesXRPUSDPrivateChannel
 	LiveTradesXRPUSDTChannel
 	LiveOrdersXRPUSDTChannel
 	OrderBookXRPUSDTChannel
 	DetailOrderBookXRPUSDTChannel
 	DiffOrderBookXRPUSDTChannel
+	MyOrdersXRPUSDTPrivateChannel
+	MyTradesXRPUSDTPrivateChannel
 	LiveTradesYFIBTCChannel
 	LiveOrdersYFIBTCChannel
 	OrderBookYFIBTCChannel
 	DetailOrderBookYFIBTCChannel
 	DiffOrderBookYFIBTCChannel
+	MyOrdersYFIBTCPrivateChannel
+	MyTradesYFIBTCPrivateChannel
 	LiveTradesYFIEURChannel
 	LiveOrdersYFIEURChannel
 	OrderBookYFIEURChannel
 	DetailOrderBookYFIEURChannel
 	DiffOrderBookYFIEURChannel
+	MyOrdersYFIEURPrivateChannel
+	MyTradesYFIEURPrivateChannel
 	LiveTradesYFIUSDChannel
 	LiveOrdersYFIUSDChannel
 	OrderBookYFIUSDChannel
 	DetailOrderBookYFIUSDChannel
 	DiffOrderBookYFIUSDChannel
+	MyOrdersYFIUSDPrivateChannel
+	MyTradesYFIUSDPrivateChannel
 	LiveTradesZRXBTCChannel
 	LiveOrdersZRXBTCChannel
 	OrderBookZRXBTCChannel
 	DetailOrderBookZRXBTCChannel
 	DiffOrderBookZRXBTCChannel
+	MyOrdersZRXBTCPrivateChannel
+	MyTradesZRXBTCPrivateChannel
 	LiveTradesZRXEURChannel
 	LiveOrdersZRXEURChannel
 	OrderBookZRXEURChannel
 	DetailOrderBookZRXEURChannel
 	DiffOrderBookZRXEURChannel
+	MyOrdersZRXEURPrivateChannel
+	MyTradesZRXEURPrivateChannel
 	LiveTradesZRXUSDChannel
 	LiveOrdersZRXUSDChannel
 	OrderBookZRXUSDChannel
 	DetailOrderBookZRXUSDChannel
 	DiffOrderBookZRXUSDChannel
+	MyOrdersZRXUSDPrivateChannel
+	MyTradesZRXUSDPrivateChannel
 )
 
 func (p Channel) String() string {
@@ -882,865 +1228,1211 @@ func getChannels() map[Channel]string {
 		OrderBookAAVEBTCChannel:        "order_book_aavebtc",
 		DetailOrderBookAAVEBTCChannel:  "detail_order_book_aavebtc",
 		DiffOrderBookAAVEBTCChannel:    "diff_order_book_aavebtc",
+		MyOrdersAAVEBTCPrivateChannel:  "private-my_orders_aavebtc",
+		MyTradesAAVEBTCPrivateChannel:  "private-my_trades_aavebtc",
 		LiveTradesAAVEEURChannel:       "live_trades_aaveeur",
 		LiveOrdersAAVEEURChannel:       "live_orders_aaveeur",
 		OrderBookAAVEEURChannel:        "order_book_aaveeur",
 		DetailOrderBookAAVEEURChannel:  "detail_order_book_aaveeur",
 		DiffOrderBookAAVEEURChannel:    "diff_order_book_aaveeur",
+		MyOrdersAAVEEURPrivateChannel:  "private-my_orders_aaveeur",
+		MyTradesAAVEEURPrivateChannel:  "private-my_trades_aaveeur",
 		LiveTradesAAVEUSDChannel:       "live_trades_aaveusd",
 		LiveOrdersAAVEUSDChannel:       "live_orders_aaveusd",
 		OrderBookAAVEUSDChannel:        "order_book_aaveusd",
 		DetailOrderBookAAVEUSDChannel:  "detail_order_book_aaveusd",
 		DiffOrderBookAAVEUSDChannel:    "diff_order_book_aaveusd",
+		MyOrdersAAVEUSDPrivateChannel:  "private-my_orders_aaveusd",
+		MyTradesAAVEUSDPrivateChannel:  "private-my_trades_aaveusd",
 		LiveTradesADABTCChannel:        "live_trades_adabtc",
 		LiveOrdersADABTCChannel:        "live_orders_adabtc",
 		OrderBookADABTCChannel:         "order_book_adabtc",
 		DetailOrderBookADABTCChannel:   "detail_order_book_adabtc",
 		DiffOrderBookADABTCChannel:     "diff_order_book_adabtc",
+		MyOrdersADABTCPrivateChannel:   "private-my_orders_adabtc",
+		MyTradesADABTCPrivateChannel:   "private-my_trades_adabtc",
 		LiveTradesADAEURChannel:        "live_trades_adaeur",
 		LiveOrdersADAEURChannel:        "live_orders_adaeur",
 		OrderBookADAEURChannel:         "order_book_adaeur",
 		DetailOrderBookADAEURChannel:   "detail_order_book_adaeur",
 		DiffOrderBookADAEURChannel:     "diff_order_book_adaeur",
+		MyOrdersADAEURPrivateChannel:   "private-my_orders_adaeur",
+		MyTradesADAEURPrivateChannel:   "private-my_trades_adaeur",
 		LiveTradesADAUSDChannel:        "live_trades_adausd",
 		LiveOrdersADAUSDChannel:        "live_orders_adausd",
 		OrderBookADAUSDChannel:         "order_book_adausd",
 		DetailOrderBookADAUSDChannel:   "detail_order_book_adausd",
 		DiffOrderBookADAUSDChannel:     "diff_order_book_adausd",
+		MyOrdersADAUSDPrivateChannel:   "private-my_orders_adausd",
+		MyTradesADAUSDPrivateChannel:   "private-my_trades_adausd",
 		LiveTradesALGOBTCChannel:       "live_trades_algobtc",
 		LiveOrdersALGOBTCChannel:       "live_orders_algobtc",
 		OrderBookALGOBTCChannel:        "order_book_algobtc",
 		DetailOrderBookALGOBTCChannel:  "detail_order_book_algobtc",
 		DiffOrderBookALGOBTCChannel:    "diff_order_book_algobtc",
+		MyOrdersALGOBTCPrivateChannel:  "private-my_orders_algobtc",
+		MyTradesALGOBTCPrivateChannel:  "private-my_trades_algobtc",
 		LiveTradesALGOEURChannel:       "live_trades_algoeur",
 		LiveOrdersALGOEURChannel:       "live_orders_algoeur",
 		OrderBookALGOEURChannel:        "order_book_algoeur",
 		DetailOrderBookALGOEURChannel:  "detail_order_book_algoeur",
 		DiffOrderBookALGOEURChannel:    "diff_order_book_algoeur",
+		MyOrdersALGOEURPrivateChannel:  "private-my_orders_algoeur",
+		MyTradesALGOEURPrivateChannel:  "private-my_trades_algoeur",
 		LiveTradesALGOUSDChannel:       "live_trades_algousd",
 		LiveOrdersALGOUSDChannel:       "live_orders_algousd",
 		OrderBookALGOUSDChannel:        "order_book_algousd",
 		DetailOrderBookALGOUSDChannel:  "detail_order_book_algousd",
 		DiffOrderBookALGOUSDChannel:    "diff_order_book_algousd",
+		MyOrdersALGOUSDPrivateChannel:  "private-my_orders_algousd",
+		MyTradesALGOUSDPrivateChannel:  "private-my_trades_algousd",
 		LiveTradesALPHAEURChannel:      "live_trades_alphaeur",
 		LiveOrdersALPHAEURChannel:      "live_orders_alphaeur",
 		OrderBookALPHAEURChannel:       "order_book_alphaeur",
 		DetailOrderBookALPHAEURChannel: "detail_order_book_alphaeur",
 		DiffOrderBookALPHAEURChannel:   "diff_order_book_alphaeur",
+		MyOrdersALPHAEURPrivateChannel: "private-my_orders_alphaeur",
+		MyTradesALPHAEURPrivateChannel: "private-my_trades_alphaeur",
 		LiveTradesALPHAUSDChannel:      "live_trades_alphausd",
 		LiveOrdersALPHAUSDChannel:      "live_orders_alphausd",
 		OrderBookALPHAUSDChannel:       "order_book_alphausd",
 		DetailOrderBookALPHAUSDChannel: "detail_order_book_alphausd",
 		DiffOrderBookALPHAUSDChannel:   "diff_order_book_alphausd",
+		MyOrdersALPHAUSDPrivateChannel: "private-my_orders_alphausd",
+		MyTradesALPHAUSDPrivateChannel: "private-my_trades_alphausd",
 		LiveTradesAMPEURChannel:        "live_trades_ampeur",
 		LiveOrdersAMPEURChannel:        "live_orders_ampeur",
 		OrderBookAMPEURChannel:         "order_book_ampeur",
 		DetailOrderBookAMPEURChannel:   "detail_order_book_ampeur",
 		DiffOrderBookAMPEURChannel:     "diff_order_book_ampeur",
+		MyOrdersAMPEURPrivateChannel:   "private-my_orders_ampeur",
+		MyTradesAMPEURPrivateChannel:   "private-my_trades_ampeur",
 		LiveTradesAMPUSDChannel:        "live_trades_ampusd",
 		LiveOrdersAMPUSDChannel:        "live_orders_ampusd",
 		OrderBookAMPUSDChannel:         "order_book_ampusd",
 		DetailOrderBookAMPUSDChannel:   "detail_order_book_ampusd",
 		DiffOrderBookAMPUSDChannel:     "diff_order_book_ampusd",
+		MyOrdersAMPUSDPrivateChannel:   "private-my_orders_ampusd",
+		MyTradesAMPUSDPrivateChannel:   "private-my_trades_ampusd",
 		LiveTradesANTEURChannel:        "live_trades_anteur",
 		LiveOrdersANTEURChannel:        "live_orders_anteur",
 		OrderBookANTEURChannel:         "order_book_anteur",
 		DetailOrderBookANTEURChannel:   "detail_order_book_anteur",
 		DiffOrderBookANTEURChannel:     "diff_order_book_anteur",
+		MyOrdersANTEURPrivateChannel:   "private-my_orders_anteur",
+		MyTradesANTEURPrivateChannel:   "private-my_trades_anteur",
 		LiveTradesANTUSDChannel:        "live_trades_antusd",
 		LiveOrdersANTUSDChannel:        "live_orders_antusd",
 		OrderBookANTUSDChannel:         "order_book_antusd",
 		DetailOrderBookANTUSDChannel:   "detail_order_book_antusd",
 		DiffOrderBookANTUSDChannel:     "diff_order_book_antusd",
+		MyOrdersANTUSDPrivateChannel:   "private-my_orders_antusd",
+		MyTradesANTUSDPrivateChannel:   "private-my_trades_antusd",
 		LiveTradesAUDIOBTCChannel:      "live_trades_audiobtc",
 		LiveOrdersAUDIOBTCChannel:      "live_orders_audiobtc",
 		OrderBookAUDIOBTCChannel:       "order_book_audiobtc",
 		DetailOrderBookAUDIOBTCChannel: "detail_order_book_audiobtc",
 		DiffOrderBookAUDIOBTCChannel:   "diff_order_book_audiobtc",
+		MyOrdersAUDIOBTCPrivateChannel: "private-my_orders_audiobtc",
+		MyTradesAUDIOBTCPrivateChannel: "private-my_trades_audiobtc",
 		LiveTradesAUDIOEURChannel:      "live_trades_audioeur",
 		LiveOrdersAUDIOEURChannel:      "live_orders_audioeur",
 		OrderBookAUDIOEURChannel:       "order_book_audioeur",
 		DetailOrderBookAUDIOEURChannel: "detail_order_book_audioeur",
 		DiffOrderBookAUDIOEURChannel:   "diff_order_book_audioeur",
+		MyOrdersAUDIOEURPrivateChannel: "private-my_orders_audioeur",
+		MyTradesAUDIOEURPrivateChannel: "private-my_trades_audioeur",
 		LiveTradesAUDIOUSDChannel:      "live_trades_audiousd",
 		LiveOrdersAUDIOUSDChannel:      "live_orders_audiousd",
 		OrderBookAUDIOUSDChannel:       "order_book_audiousd",
 		DetailOrderBookAUDIOUSDChannel: "detail_order_book_audiousd",
 		DiffOrderBookAUDIOUSDChannel:   "diff_order_book_audiousd",
+		MyOrdersAUDIOUSDPrivateChannel: "private-my_orders_audiousd",
+		MyTradesAUDIOUSDPrivateChannel: "private-my_trades_audiousd",
 		LiveTradesAVAXEURChannel:       "live_trades_avaxeur",
 		LiveOrdersAVAXEURChannel:       "live_orders_avaxeur",
 		OrderBookAVAXEURChannel:        "order_book_avaxeur",
 		DetailOrderBookAVAXEURChannel:  "detail_order_book_avaxeur",
 		DiffOrderBookAVAXEURChannel:    "diff_order_book_avaxeur",
+		MyOrdersAVAXEURPrivateChannel:  "private-my_orders_avaxeur",
+		MyTradesAVAXEURPrivateChannel:  "private-my_trades_avaxeur",
 		LiveTradesAVAXUSDChannel:       "live_trades_avaxusd",
 		LiveOrdersAVAXUSDChannel:       "live_orders_avaxusd",
 		OrderBookAVAXUSDChannel:        "order_book_avaxusd",
 		DetailOrderBookAVAXUSDChannel:  "detail_order_book_avaxusd",
 		DiffOrderBookAVAXUSDChannel:    "diff_order_book_avaxusd",
+		MyOrdersAVAXUSDPrivateChannel:  "private-my_orders_avaxusd",
+		MyTradesAVAXUSDPrivateChannel:  "private-my_trades_avaxusd",
 		LiveTradesAXSEURChannel:        "live_trades_axseur",
 		LiveOrdersAXSEURChannel:        "live_orders_axseur",
 		OrderBookAXSEURChannel:         "order_book_axseur",
 		DetailOrderBookAXSEURChannel:   "detail_order_book_axseur",
 		DiffOrderBookAXSEURChannel:     "diff_order_book_axseur",
+		MyOrdersAXSEURPrivateChannel:   "private-my_orders_axseur",
+		MyTradesAXSEURPrivateChannel:   "private-my_trades_axseur",
 		LiveTradesAXSUSDChannel:        "live_trades_axsusd",
 		LiveOrdersAXSUSDChannel:        "live_orders_axsusd",
 		OrderBookAXSUSDChannel:         "order_book_axsusd",
 		DetailOrderBookAXSUSDChannel:   "detail_order_book_axsusd",
 		DiffOrderBookAXSUSDChannel:     "diff_order_book_axsusd",
+		MyOrdersAXSUSDPrivateChannel:   "private-my_orders_axsusd",
+		MyTradesAXSUSDPrivateChannel:   "private-my_trades_axsusd",
 		LiveTradesBANDEURChannel:       "live_trades_bandeur",
 		LiveOrdersBANDEURChannel:       "live_orders_bandeur",
 		OrderBookBANDEURChannel:        "order_book_bandeur",
 		DetailOrderBookBANDEURChannel:  "detail_order_book_bandeur",
 		DiffOrderBookBANDEURChannel:    "diff_order_book_bandeur",
+		MyOrdersBANDEURPrivateChannel:  "private-my_orders_bandeur",
+		MyTradesBANDEURPrivateChannel:  "private-my_trades_bandeur",
 		LiveTradesBANDUSDChannel:       "live_trades_bandusd",
 		LiveOrdersBANDUSDChannel:       "live_orders_bandusd",
 		OrderBookBANDUSDChannel:        "order_book_bandusd",
 		DetailOrderBookBANDUSDChannel:  "detail_order_book_bandusd",
 		DiffOrderBookBANDUSDChannel:    "diff_order_book_bandusd",
+		MyOrdersBANDUSDPrivateChannel:  "private-my_orders_bandusd",
+		MyTradesBANDUSDPrivateChannel:  "private-my_trades_bandusd",
 		LiveTradesBATBTCChannel:        "live_trades_batbtc",
 		LiveOrdersBATBTCChannel:        "live_orders_batbtc",
 		OrderBookBATBTCChannel:         "order_book_batbtc",
 		DetailOrderBookBATBTCChannel:   "detail_order_book_batbtc",
 		DiffOrderBookBATBTCChannel:     "diff_order_book_batbtc",
+		MyOrdersBATBTCPrivateChannel:   "private-my_orders_batbtc",
+		MyTradesBATBTCPrivateChannel:   "private-my_trades_batbtc",
 		LiveTradesBATEURChannel:        "live_trades_bateur",
 		LiveOrdersBATEURChannel:        "live_orders_bateur",
 		OrderBookBATEURChannel:         "order_book_bateur",
 		DetailOrderBookBATEURChannel:   "detail_order_book_bateur",
 		DiffOrderBookBATEURChannel:     "diff_order_book_bateur",
+		MyOrdersBATEURPrivateChannel:   "private-my_orders_bateur",
+		MyTradesBATEURPrivateChannel:   "private-my_trades_bateur",
 		LiveTradesBATUSDChannel:        "live_trades_batusd",
 		LiveOrdersBATUSDChannel:        "live_orders_batusd",
 		OrderBookBATUSDChannel:         "order_book_batusd",
 		DetailOrderBookBATUSDChannel:   "detail_order_book_batusd",
 		DiffOrderBookBATUSDChannel:     "diff_order_book_batusd",
+		MyOrdersBATUSDPrivateChannel:   "private-my_orders_batusd",
+		MyTradesBATUSDPrivateChannel:   "private-my_trades_batusd",
 		LiveTradesBCHBTCChannel:        "live_trades_bchbtc",
 		LiveOrdersBCHBTCChannel:        "live_orders_bchbtc",
 		OrderBookBCHBTCChannel:         "order_book_bchbtc",
 		DetailOrderBookBCHBTCChannel:   "detail_order_book_bchbtc",
 		DiffOrderBookBCHBTCChannel:     "diff_order_book_bchbtc",
+		MyOrdersBCHBTCPrivateChannel:   "private-my_orders_bchbtc",
+		MyTradesBCHBTCPrivateChannel:   "private-my_trades_bchbtc",
 		LiveTradesBCHEURChannel:        "live_trades_bcheur",
 		LiveOrdersBCHEURChannel:        "live_orders_bcheur",
 		OrderBookBCHEURChannel:         "order_book_bcheur",
 		DetailOrderBookBCHEURChannel:   "detail_order_book_bcheur",
 		DiffOrderBookBCHEURChannel:     "diff_order_book_bcheur",
+		MyOrdersBCHEURPrivateChannel:   "private-my_orders_bcheur",
+		MyTradesBCHEURPrivateChannel:   "private-my_trades_bcheur",
 		LiveTradesBCHGBPChannel:        "live_trades_bchgbp",
 		LiveOrdersBCHGBPChannel:        "live_orders_bchgbp",
 		OrderBookBCHGBPChannel:         "order_book_bchgbp",
 		DetailOrderBookBCHGBPChannel:   "detail_order_book_bchgbp",
 		DiffOrderBookBCHGBPChannel:     "diff_order_book_bchgbp",
+		MyOrdersBCHGBPPrivateChannel:   "private-my_orders_bchgbp",
+		MyTradesBCHGBPPrivateChannel:   "private-my_trades_bchgbp",
 		LiveTradesBCHUSDChannel:        "live_trades_bchusd",
 		LiveOrdersBCHUSDChannel:        "live_orders_bchusd",
 		OrderBookBCHUSDChannel:         "order_book_bchusd",
 		DetailOrderBookBCHUSDChannel:   "detail_order_book_bchusd",
 		DiffOrderBookBCHUSDChannel:     "diff_order_book_bchusd",
+		MyOrdersBCHUSDPrivateChannel:   "private-my_orders_bchusd",
+		MyTradesBCHUSDPrivateChannel:   "private-my_trades_bchusd",
 		LiveTradesBTCEURChannel:        "live_trades_btceur",
 		LiveOrdersBTCEURChannel:        "live_orders_btceur",
 		OrderBookBTCEURChannel:         "order_book_btceur",
 		DetailOrderBookBTCEURChannel:   "detail_order_book_btceur",
 		DiffOrderBookBTCEURChannel:     "diff_order_book_btceur",
+		MyOrdersBTCEURPrivateChannel:   "private-my_orders_btceur",
+		MyTradesBTCEURPrivateChannel:   "private-my_trades_btceur",
 		LiveTradesBTCGBPChannel:        "live_trades_btcgbp",
 		LiveOrdersBTCGBPChannel:        "live_orders_btcgbp",
 		OrderBookBTCGBPChannel:         "order_book_btcgbp",
 		DetailOrderBookBTCGBPChannel:   "detail_order_book_btcgbp",
 		DiffOrderBookBTCGBPChannel:     "diff_order_book_btcgbp",
+		MyOrdersBTCGBPPrivateChannel:   "private-my_orders_btcgbp",
+		MyTradesBTCGBPPrivateChannel:   "private-my_trades_btcgbp",
 		LiveTradesBTCPAXChannel:        "live_trades_btcpax",
 		LiveOrdersBTCPAXChannel:        "live_orders_btcpax",
 		OrderBookBTCPAXChannel:         "order_book_btcpax",
 		DetailOrderBookBTCPAXChannel:   "detail_order_book_btcpax",
 		DiffOrderBookBTCPAXChannel:     "diff_order_book_btcpax",
+		MyOrdersBTCPAXPrivateChannel:   "private-my_orders_btcpax",
+		MyTradesBTCPAXPrivateChannel:   "private-my_trades_btcpax",
 		LiveTradesBTCUSDChannel:        "live_trades_btcusd",
 		LiveOrdersBTCUSDChannel:        "live_orders_btcusd",
 		OrderBookBTCUSDChannel:         "order_book_btcusd",
 		DetailOrderBookBTCUSDChannel:   "detail_order_book_btcusd",
 		DiffOrderBookBTCUSDChannel:     "diff_order_book_btcusd",
+		MyOrdersBTCUSDPrivateChannel:   "private-my_orders_btcusd",
+		MyTradesBTCUSDPrivateChannel:   "private-my_trades_btcusd",
 		LiveTradesBTCUSDCChannel:       "live_trades_btcusdc",
 		LiveOrdersBTCUSDCChannel:       "live_orders_btcusdc",
 		OrderBookBTCUSDCChannel:        "order_book_btcusdc",
 		DetailOrderBookBTCUSDCChannel:  "detail_order_book_btcusdc",
 		DiffOrderBookBTCUSDCChannel:    "diff_order_book_btcusdc",
+		MyOrdersBTCUSDCPrivateChannel:  "private-my_orders_btcusdc",
+		MyTradesBTCUSDCPrivateChannel:  "private-my_trades_btcusdc",
 		LiveTradesBTCUSDTChannel:       "live_trades_btcusdt",
 		LiveOrdersBTCUSDTChannel:       "live_orders_btcusdt",
 		OrderBookBTCUSDTChannel:        "order_book_btcusdt",
 		DetailOrderBookBTCUSDTChannel:  "detail_order_book_btcusdt",
 		DiffOrderBookBTCUSDTChannel:    "diff_order_book_btcusdt",
+		MyOrdersBTCUSDTPrivateChannel:  "private-my_orders_btcusdt",
+		MyTradesBTCUSDTPrivateChannel:  "private-my_trades_btcusdt",
 		LiveTradesCELEURChannel:        "live_trades_celeur",
 		LiveOrdersCELEURChannel:        "live_orders_celeur",
 		OrderBookCELEURChannel:         "order_book_celeur",
 		DetailOrderBookCELEURChannel:   "detail_order_book_celeur",
 		DiffOrderBookCELEURChannel:     "diff_order_book_celeur",
+		MyOrdersCELEURPrivateChannel:   "private-my_orders_celeur",
+		MyTradesCELEURPrivateChannel:   "private-my_trades_celeur",
 		LiveTradesCELUSDChannel:        "live_trades_celusd",
 		LiveOrdersCELUSDChannel:        "live_orders_celusd",
 		OrderBookCELUSDChannel:         "order_book_celusd",
 		DetailOrderBookCELUSDChannel:   "detail_order_book_celusd",
 		DiffOrderBookCELUSDChannel:     "diff_order_book_celusd",
+		MyOrdersCELUSDPrivateChannel:   "private-my_orders_celusd",
+		MyTradesCELUSDPrivateChannel:   "private-my_trades_celusd",
 		LiveTradesCHZEURChannel:        "live_trades_chzeur",
 		LiveOrdersCHZEURChannel:        "live_orders_chzeur",
 		OrderBookCHZEURChannel:         "order_book_chzeur",
 		DetailOrderBookCHZEURChannel:   "detail_order_book_chzeur",
 		DiffOrderBookCHZEURChannel:     "diff_order_book_chzeur",
+		MyOrdersCHZEURPrivateChannel:   "private-my_orders_chzeur",
+		MyTradesCHZEURPrivateChannel:   "private-my_trades_chzeur",
 		LiveTradesCHZUSDChannel:        "live_trades_chzusd",
 		LiveOrdersCHZUSDChannel:        "live_orders_chzusd",
 		OrderBookCHZUSDChannel:         "order_book_chzusd",
 		DetailOrderBookCHZUSDChannel:   "detail_order_book_chzusd",
 		DiffOrderBookCHZUSDChannel:     "diff_order_book_chzusd",
+		MyOrdersCHZUSDPrivateChannel:   "private-my_orders_chzusd",
+		MyTradesCHZUSDPrivateChannel:   "private-my_trades_chzusd",
 		LiveTradesCOMPBTCChannel:       "live_trades_compbtc",
 		LiveOrdersCOMPBTCChannel:       "live_orders_compbtc",
 		OrderBookCOMPBTCChannel:        "order_book_compbtc",
 		DetailOrderBookCOMPBTCChannel:  "detail_order_book_compbtc",
 		DiffOrderBookCOMPBTCChannel:    "diff_order_book_compbtc",
+		MyOrdersCOMPBTCPrivateChannel:  "private-my_orders_compbtc",
+		MyTradesCOMPBTCPrivateChannel:  "private-my_trades_compbtc",
 		LiveTradesCOMPEURChannel:       "live_trades_compeur",
 		LiveOrdersCOMPEURChannel:       "live_orders_compeur",
 		OrderBookCOMPEURChannel:        "order_book_compeur",
 		DetailOrderBookCOMPEURChannel:  "detail_order_book_compeur",
 		DiffOrderBookCOMPEURChannel:    "diff_order_book_compeur",
+		MyOrdersCOMPEURPrivateChannel:  "private-my_orders_compeur",
+		MyTradesCOMPEURPrivateChannel:  "private-my_trades_compeur",
 		LiveTradesCOMPUSDChannel:       "live_trades_compusd",
 		LiveOrdersCOMPUSDChannel:       "live_orders_compusd",
 		OrderBookCOMPUSDChannel:        "order_book_compusd",
 		DetailOrderBookCOMPUSDChannel:  "detail_order_book_compusd",
 		DiffOrderBookCOMPUSDChannel:    "diff_order_book_compusd",
+		MyOrdersCOMPUSDPrivateChannel:  "private-my_orders_compusd",
+		MyTradesCOMPUSDPrivateChannel:  "private-my_trades_compusd",
 		LiveTradesCRVBTCChannel:        "live_trades_crvbtc",
 		LiveOrdersCRVBTCChannel:        "live_orders_crvbtc",
 		OrderBookCRVBTCChannel:         "order_book_crvbtc",
 		DetailOrderBookCRVBTCChannel:   "detail_order_book_crvbtc",
 		DiffOrderBookCRVBTCChannel:     "diff_order_book_crvbtc",
+		MyOrdersCRVBTCPrivateChannel:   "private-my_orders_crvbtc",
+		MyTradesCRVBTCPrivateChannel:   "private-my_trades_crvbtc",
 		LiveTradesCRVEURChannel:        "live_trades_crveur",
 		LiveOrdersCRVEURChannel:        "live_orders_crveur",
 		OrderBookCRVEURChannel:         "order_book_crveur",
 		DetailOrderBookCRVEURChannel:   "detail_order_book_crveur",
 		DiffOrderBookCRVEURChannel:     "diff_order_book_crveur",
+		MyOrdersCRVEURPrivateChannel:   "private-my_orders_crveur",
+		MyTradesCRVEURPrivateChannel:   "private-my_trades_crveur",
 		LiveTradesCRVUSDChannel:        "live_trades_crvusd",
 		LiveOrdersCRVUSDChannel:        "live_orders_crvusd",
 		OrderBookCRVUSDChannel:         "order_book_crvusd",
 		DetailOrderBookCRVUSDChannel:   "detail_order_book_crvusd",
 		DiffOrderBookCRVUSDChannel:     "diff_order_book_crvusd",
+		MyOrdersCRVUSDPrivateChannel:   "private-my_orders_crvusd",
+		MyTradesCRVUSDPrivateChannel:   "private-my_trades_crvusd",
 		LiveTradesCTSIEURChannel:       "live_trades_ctsieur",
 		LiveOrdersCTSIEURChannel:       "live_orders_ctsieur",
 		OrderBookCTSIEURChannel:        "order_book_ctsieur",
 		DetailOrderBookCTSIEURChannel:  "detail_order_book_ctsieur",
 		DiffOrderBookCTSIEURChannel:    "diff_order_book_ctsieur",
+		MyOrdersCTSIEURPrivateChannel:  "private-my_orders_ctsieur",
+		MyTradesCTSIEURPrivateChannel:  "private-my_trades_ctsieur",
 		LiveTradesCTSIUSDChannel:       "live_trades_ctsiusd",
 		LiveOrdersCTSIUSDChannel:       "live_orders_ctsiusd",
 		OrderBookCTSIUSDChannel:        "order_book_ctsiusd",
 		DetailOrderBookCTSIUSDChannel:  "detail_order_book_ctsiusd",
 		DiffOrderBookCTSIUSDChannel:    "diff_order_book_ctsiusd",
+		MyOrdersCTSIUSDPrivateChannel:  "private-my_orders_ctsiusd",
+		MyTradesCTSIUSDPrivateChannel:  "private-my_trades_ctsiusd",
 		LiveTradesCVXEURChannel:        "live_trades_cvxeur",
 		LiveOrdersCVXEURChannel:        "live_orders_cvxeur",
 		OrderBookCVXEURChannel:         "order_book_cvxeur",
 		DetailOrderBookCVXEURChannel:   "detail_order_book_cvxeur",
 		DiffOrderBookCVXEURChannel:     "diff_order_book_cvxeur",
+		MyOrdersCVXEURPrivateChannel:   "private-my_orders_cvxeur",
+		MyTradesCVXEURPrivateChannel:   "private-my_trades_cvxeur",
 		LiveTradesCVXUSDChannel:        "live_trades_cvxusd",
 		LiveOrdersCVXUSDChannel:        "live_orders_cvxusd",
 		OrderBookCVXUSDChannel:         "order_book_cvxusd",
 		DetailOrderBookCVXUSDChannel:   "detail_order_book_cvxusd",
 		DiffOrderBookCVXUSDChannel:     "diff_order_book_cvxusd",
+		MyOrdersCVXUSDPrivateChannel:   "private-my_orders_cvxusd",
+		MyTradesCVXUSDPrivateChannel:   "private-my_trades_cvxusd",
 		LiveTradesDAIUSDChannel:        "live_trades_daiusd",
 		LiveOrdersDAIUSDChannel:        "live_orders_daiusd",
 		OrderBookDAIUSDChannel:         "order_book_daiusd",
 		DetailOrderBookDAIUSDChannel:   "detail_order_book_daiusd",
 		DiffOrderBookDAIUSDChannel:     "diff_order_book_daiusd",
+		MyOrdersDAIUSDPrivateChannel:   "private-my_orders_daiusd",
+		MyTradesDAIUSDPrivateChannel:   "private-my_trades_daiusd",
 		LiveTradesDYDXEURChannel:       "live_trades_dydxeur",
 		LiveOrdersDYDXEURChannel:       "live_orders_dydxeur",
 		OrderBookDYDXEURChannel:        "order_book_dydxeur",
 		DetailOrderBookDYDXEURChannel:  "detail_order_book_dydxeur",
 		DiffOrderBookDYDXEURChannel:    "diff_order_book_dydxeur",
+		MyOrdersDYDXEURPrivateChannel:  "private-my_orders_dydxeur",
+		MyTradesDYDXEURPrivateChannel:  "private-my_trades_dydxeur",
 		LiveTradesDYDXUSDChannel:       "live_trades_dydxusd",
 		LiveOrdersDYDXUSDChannel:       "live_orders_dydxusd",
 		OrderBookDYDXUSDChannel:        "order_book_dydxusd",
 		DetailOrderBookDYDXUSDChannel:  "detail_order_book_dydxusd",
 		DiffOrderBookDYDXUSDChannel:    "diff_order_book_dydxusd",
+		MyOrdersDYDXUSDPrivateChannel:  "private-my_orders_dydxusd",
+		MyTradesDYDXUSDPrivateChannel:  "private-my_trades_dydxusd",
 		LiveTradesENJEURChannel:        "live_trades_enjeur",
 		LiveOrdersENJEURChannel:        "live_orders_enjeur",
 		OrderBookENJEURChannel:         "order_book_enjeur",
 		DetailOrderBookENJEURChannel:   "detail_order_book_enjeur",
 		DiffOrderBookENJEURChannel:     "diff_order_book_enjeur",
+		MyOrdersENJEURPrivateChannel:   "private-my_orders_enjeur",
+		MyTradesENJEURPrivateChannel:   "private-my_trades_enjeur",
 		LiveTradesENJUSDChannel:        "live_trades_enjusd",
 		LiveOrdersENJUSDChannel:        "live_orders_enjusd",
 		OrderBookENJUSDChannel:         "order_book_enjusd",
 		DetailOrderBookENJUSDChannel:   "detail_order_book_enjusd",
 		DiffOrderBookENJUSDChannel:     "diff_order_book_enjusd",
+		MyOrdersENJUSDPrivateChannel:   "private-my_orders_enjusd",
+		MyTradesENJUSDPrivateChannel:   "private-my_trades_enjusd",
 		LiveTradesETH2ETHChannel:       "live_trades_eth2eth",
 		LiveOrdersETH2ETHChannel:       "live_orders_eth2eth",
 		OrderBookETH2ETHChannel:        "order_book_eth2eth",
 		DetailOrderBookETH2ETHChannel:  "detail_order_book_eth2eth",
 		DiffOrderBookETH2ETHChannel:    "diff_order_book_eth2eth",
+		MyOrdersETH2ETHPrivateChannel:  "private-my_orders_eth2eth",
+		MyTradesETH2ETHPrivateChannel:  "private-my_trades_eth2eth",
 		LiveTradesETHBTCChannel:        "live_trades_ethbtc",
 		LiveOrdersETHBTCChannel:        "live_orders_ethbtc",
 		OrderBookETHBTCChannel:         "order_book_ethbtc",
 		DetailOrderBookETHBTCChannel:   "detail_order_book_ethbtc",
 		DiffOrderBookETHBTCChannel:     "diff_order_book_ethbtc",
+		MyOrdersETHBTCPrivateChannel:   "private-my_orders_ethbtc",
+		MyTradesETHBTCPrivateChannel:   "private-my_trades_ethbtc",
 		LiveTradesETHEURChannel:        "live_trades_etheur",
 		LiveOrdersETHEURChannel:        "live_orders_etheur",
 		OrderBookETHEURChannel:         "order_book_etheur",
 		DetailOrderBookETHEURChannel:   "detail_order_book_etheur",
 		DiffOrderBookETHEURChannel:     "diff_order_book_etheur",
+		MyOrdersETHEURPrivateChannel:   "private-my_orders_etheur",
+		MyTradesETHEURPrivateChannel:   "private-my_trades_etheur",
 		LiveTradesETHGBPChannel:        "live_trades_ethgbp",
 		LiveOrdersETHGBPChannel:        "live_orders_ethgbp",
 		OrderBookETHGBPChannel:         "order_book_ethgbp",
 		DetailOrderBookETHGBPChannel:   "detail_order_book_ethgbp",
 		DiffOrderBookETHGBPChannel:     "diff_order_book_ethgbp",
+		MyOrdersETHGBPPrivateChannel:   "private-my_orders_ethgbp",
+		MyTradesETHGBPPrivateChannel:   "private-my_trades_ethgbp",
 		LiveTradesETHPAXChannel:        "live_trades_ethpax",
 		LiveOrdersETHPAXChannel:        "live_orders_ethpax",
 		OrderBookETHPAXChannel:         "order_book_ethpax",
 		DetailOrderBookETHPAXChannel:   "detail_order_book_ethpax",
 		DiffOrderBookETHPAXChannel:     "diff_order_book_ethpax",
+		MyOrdersETHPAXPrivateChannel:   "private-my_orders_ethpax",
+		MyTradesETHPAXPrivateChannel:   "private-my_trades_ethpax",
 		LiveTradesETHUSDChannel:        "live_trades_ethusd",
 		LiveOrdersETHUSDChannel:        "live_orders_ethusd",
 		OrderBookETHUSDChannel:         "order_book_ethusd",
 		DetailOrderBookETHUSDChannel:   "detail_order_book_ethusd",
 		DiffOrderBookETHUSDChannel:     "diff_order_book_ethusd",
+		MyOrdersETHUSDPrivateChannel:   "private-my_orders_ethusd",
+		MyTradesETHUSDPrivateChannel:   "private-my_trades_ethusd",
 		LiveTradesETHUSDCChannel:       "live_trades_ethusdc",
 		LiveOrdersETHUSDCChannel:       "live_orders_ethusdc",
 		OrderBookETHUSDCChannel:        "order_book_ethusdc",
 		DetailOrderBookETHUSDCChannel:  "detail_order_book_ethusdc",
 		DiffOrderBookETHUSDCChannel:    "diff_order_book_ethusdc",
+		MyOrdersETHUSDCPrivateChannel:  "private-my_orders_ethusdc",
+		MyTradesETHUSDCPrivateChannel:  "private-my_trades_ethusdc",
 		LiveTradesETHUSDTChannel:       "live_trades_ethusdt",
 		LiveOrdersETHUSDTChannel:       "live_orders_ethusdt",
 		OrderBookETHUSDTChannel:        "order_book_ethusdt",
 		DetailOrderBookETHUSDTChannel:  "detail_order_book_ethusdt",
 		DiffOrderBookETHUSDTChannel:    "diff_order_book_ethusdt",
+		MyOrdersETHUSDTPrivateChannel:  "private-my_orders_ethusdt",
+		MyTradesETHUSDTPrivateChannel:  "private-my_trades_ethusdt",
 		LiveTradesEURTEURChannel:       "live_trades_eurteur",
 		LiveOrdersEURTEURChannel:       "live_orders_eurteur",
 		OrderBookEURTEURChannel:        "order_book_eurteur",
 		DetailOrderBookEURTEURChannel:  "detail_order_book_eurteur",
 		DiffOrderBookEURTEURChannel:    "diff_order_book_eurteur",
+		MyOrdersEURTEURPrivateChannel:  "private-my_orders_eurteur",
+		MyTradesEURTEURPrivateChannel:  "private-my_trades_eurteur",
 		LiveTradesEURTUSDChannel:       "live_trades_eurtusd",
 		LiveOrdersEURTUSDChannel:       "live_orders_eurtusd",
 		OrderBookEURTUSDChannel:        "order_book_eurtusd",
 		DetailOrderBookEURTUSDChannel:  "detail_order_book_eurtusd",
 		DiffOrderBookEURTUSDChannel:    "diff_order_book_eurtusd",
+		MyOrdersEURTUSDPrivateChannel:  "private-my_orders_eurtusd",
+		MyTradesEURTUSDPrivateChannel:  "private-my_trades_eurtusd",
 		LiveTradesEURUSDChannel:        "live_trades_eurusd",
 		LiveOrdersEURUSDChannel:        "live_orders_eurusd",
 		OrderBookEURUSDChannel:         "order_book_eurusd",
 		DetailOrderBookEURUSDChannel:   "detail_order_book_eurusd",
 		DiffOrderBookEURUSDChannel:     "diff_order_book_eurusd",
+		MyOrdersEURUSDPrivateChannel:   "private-my_orders_eurusd",
+		MyTradesEURUSDPrivateChannel:   "private-my_trades_eurusd",
 		LiveTradesFETEURChannel:        "live_trades_feteur",
 		LiveOrdersFETEURChannel:        "live_orders_feteur",
 		OrderBookFETEURChannel:         "order_book_feteur",
 		DetailOrderBookFETEURChannel:   "detail_order_book_feteur",
 		DiffOrderBookFETEURChannel:     "diff_order_book_feteur",
+		MyOrdersFETEURPrivateChannel:   "private-my_orders_feteur",
+		MyTradesFETEURPrivateChannel:   "private-my_trades_feteur",
 		LiveTradesFETUSDChannel:        "live_trades_fetusd",
 		LiveOrdersFETUSDChannel:        "live_orders_fetusd",
 		OrderBookFETUSDChannel:         "order_book_fetusd",
 		DetailOrderBookFETUSDChannel:   "detail_order_book_fetusd",
 		DiffOrderBookFETUSDChannel:     "diff_order_book_fetusd",
+		MyOrdersFETUSDPrivateChannel:   "private-my_orders_fetusd",
+		MyTradesFETUSDPrivateChannel:   "private-my_trades_fetusd",
 		LiveTradesFTMEURChannel:        "live_trades_ftmeur",
 		LiveOrdersFTMEURChannel:        "live_orders_ftmeur",
 		OrderBookFTMEURChannel:         "order_book_ftmeur",
 		DetailOrderBookFTMEURChannel:   "detail_order_book_ftmeur",
 		DiffOrderBookFTMEURChannel:     "diff_order_book_ftmeur",
+		MyOrdersFTMEURPrivateChannel:   "private-my_orders_ftmeur",
+		MyTradesFTMEURPrivateChannel:   "private-my_trades_ftmeur",
 		LiveTradesFTMUSDChannel:        "live_trades_ftmusd",
 		LiveOrdersFTMUSDChannel:        "live_orders_ftmusd",
 		OrderBookFTMUSDChannel:         "order_book_ftmusd",
 		DetailOrderBookFTMUSDChannel:   "detail_order_book_ftmusd",
 		DiffOrderBookFTMUSDChannel:     "diff_order_book_ftmusd",
+		MyOrdersFTMUSDPrivateChannel:   "private-my_orders_ftmusd",
+		MyTradesFTMUSDPrivateChannel:   "private-my_trades_ftmusd",
 		LiveTradesFTTEURChannel:        "live_trades_ftteur",
 		LiveOrdersFTTEURChannel:        "live_orders_ftteur",
 		OrderBookFTTEURChannel:         "order_book_ftteur",
 		DetailOrderBookFTTEURChannel:   "detail_order_book_ftteur",
 		DiffOrderBookFTTEURChannel:     "diff_order_book_ftteur",
+		MyOrdersFTTEURPrivateChannel:   "private-my_orders_ftteur",
+		MyTradesFTTEURPrivateChannel:   "private-my_trades_ftteur",
 		LiveTradesFTTUSDChannel:        "live_trades_fttusd",
 		LiveOrdersFTTUSDChannel:        "live_orders_fttusd",
 		OrderBookFTTUSDChannel:         "order_book_fttusd",
 		DetailOrderBookFTTUSDChannel:   "detail_order_book_fttusd",
 		DiffOrderBookFTTUSDChannel:     "diff_order_book_fttusd",
+		MyOrdersFTTUSDPrivateChannel:   "private-my_orders_fttusd",
+		MyTradesFTTUSDPrivateChannel:   "private-my_trades_fttusd",
 		LiveTradesGALAEURChannel:       "live_trades_galaeur",
 		LiveOrdersGALAEURChannel:       "live_orders_galaeur",
 		OrderBookGALAEURChannel:        "order_book_galaeur",
 		DetailOrderBookGALAEURChannel:  "detail_order_book_galaeur",
 		DiffOrderBookGALAEURChannel:    "diff_order_book_galaeur",
+		MyOrdersGALAEURPrivateChannel:  "private-my_orders_galaeur",
+		MyTradesGALAEURPrivateChannel:  "private-my_trades_galaeur",
 		LiveTradesGALAUSDChannel:       "live_trades_galausd",
 		LiveOrdersGALAUSDChannel:       "live_orders_galausd",
 		OrderBookGALAUSDChannel:        "order_book_galausd",
 		DetailOrderBookGALAUSDChannel:  "detail_order_book_galausd",
 		DiffOrderBookGALAUSDChannel:    "diff_order_book_galausd",
+		MyOrdersGALAUSDPrivateChannel:  "private-my_orders_galausd",
+		MyTradesGALAUSDPrivateChannel:  "private-my_trades_galausd",
 		LiveTradesGBPEURChannel:        "live_trades_gbpeur",
 		LiveOrdersGBPEURChannel:        "live_orders_gbpeur",
 		OrderBookGBPEURChannel:         "order_book_gbpeur",
 		DetailOrderBookGBPEURChannel:   "detail_order_book_gbpeur",
 		DiffOrderBookGBPEURChannel:     "diff_order_book_gbpeur",
+		MyOrdersGBPEURPrivateChannel:   "private-my_orders_gbpeur",
+		MyTradesGBPEURPrivateChannel:   "private-my_trades_gbpeur",
 		LiveTradesGBPUSDChannel:        "live_trades_gbpusd",
 		LiveOrdersGBPUSDChannel:        "live_orders_gbpusd",
 		OrderBookGBPUSDChannel:         "order_book_gbpusd",
 		DetailOrderBookGBPUSDChannel:   "detail_order_book_gbpusd",
 		DiffOrderBookGBPUSDChannel:     "diff_order_book_gbpusd",
+		MyOrdersGBPUSDPrivateChannel:   "private-my_orders_gbpusd",
+		MyTradesGBPUSDPrivateChannel:   "private-my_trades_gbpusd",
 		LiveTradesGODSEURChannel:       "live_trades_godseur",
 		LiveOrdersGODSEURChannel:       "live_orders_godseur",
 		OrderBookGODSEURChannel:        "order_book_godseur",
 		DetailOrderBookGODSEURChannel:  "detail_order_book_godseur",
 		DiffOrderBookGODSEURChannel:    "diff_order_book_godseur",
+		MyOrdersGODSEURPrivateChannel:  "private-my_orders_godseur",
+		MyTradesGODSEURPrivateChannel:  "private-my_trades_godseur",
 		LiveTradesGODSUSDChannel:       "live_trades_godsusd",
 		LiveOrdersGODSUSDChannel:       "live_orders_godsusd",
 		OrderBookGODSUSDChannel:        "order_book_godsusd",
 		DetailOrderBookGODSUSDChannel:  "detail_order_book_godsusd",
 		DiffOrderBookGODSUSDChannel:    "diff_order_book_godsusd",
+		MyOrdersGODSUSDPrivateChannel:  "private-my_orders_godsusd",
+		MyTradesGODSUSDPrivateChannel:  "private-my_trades_godsusd",
 		LiveTradesGRTEURChannel:        "live_trades_grteur",
 		LiveOrdersGRTEURChannel:        "live_orders_grteur",
 		OrderBookGRTEURChannel:         "order_book_grteur",
 		DetailOrderBookGRTEURChannel:   "detail_order_book_grteur",
 		DiffOrderBookGRTEURChannel:     "diff_order_book_grteur",
+		MyOrdersGRTEURPrivateChannel:   "private-my_orders_grteur",
+		MyTradesGRTEURPrivateChannel:   "private-my_trades_grteur",
 		LiveTradesGRTUSDChannel:        "live_trades_grtusd",
 		LiveOrdersGRTUSDChannel:        "live_orders_grtusd",
 		OrderBookGRTUSDChannel:         "order_book_grtusd",
 		DetailOrderBookGRTUSDChannel:   "detail_order_book_grtusd",
 		DiffOrderBookGRTUSDChannel:     "diff_order_book_grtusd",
+		MyOrdersGRTUSDPrivateChannel:   "private-my_orders_grtusd",
+		MyTradesGRTUSDPrivateChannel:   "private-my_trades_grtusd",
 		LiveTradesGUSDUSDChannel:       "live_trades_gusdusd",
 		LiveOrdersGUSDUSDChannel:       "live_orders_gusdusd",
 		OrderBookGUSDUSDChannel:        "order_book_gusdusd",
 		DetailOrderBookGUSDUSDChannel:  "detail_order_book_gusdusd",
 		DiffOrderBookGUSDUSDChannel:    "diff_order_book_gusdusd",
+		MyOrdersGUSDUSDPrivateChannel:  "private-my_orders_gusdusd",
+		MyTradesGUSDUSDPrivateChannel:  "private-my_trades_gusdusd",
 		LiveTradesHBAREURChannel:       "live_trades_hbareur",
 		LiveOrdersHBAREURChannel:       "live_orders_hbareur",
 		OrderBookHBAREURChannel:        "order_book_hbareur",
 		DetailOrderBookHBAREURChannel:  "detail_order_book_hbareur",
 		DiffOrderBookHBAREURChannel:    "diff_order_book_hbareur",
+		MyOrdersHBAREURPrivateChannel:  "private-my_orders_hbareur",
+		MyTradesHBAREURPrivateChannel:  "private-my_trades_hbareur",
 		LiveTradesHBARUSDChannel:       "live_trades_hbarusd",
 		LiveOrdersHBARUSDChannel:       "live_orders_hbarusd",
 		OrderBookHBARUSDChannel:        "order_book_hbarusd",
 		DetailOrderBookHBARUSDChannel:  "detail_order_book_hbarusd",
 		DiffOrderBookHBARUSDChannel:    "diff_order_book_hbarusd",
+		MyOrdersHBARUSDPrivateChannel:  "private-my_orders_hbarusd",
+		MyTradesHBARUSDPrivateChannel:  "private-my_trades_hbarusd",
 		LiveTradesIMXEURChannel:        "live_trades_imxeur",
 		LiveOrdersIMXEURChannel:        "live_orders_imxeur",
 		OrderBookIMXEURChannel:         "order_book_imxeur",
 		DetailOrderBookIMXEURChannel:   "detail_order_book_imxeur",
 		DiffOrderBookIMXEURChannel:     "diff_order_book_imxeur",
+		MyOrdersIMXEURPrivateChannel:   "private-my_orders_imxeur",
+		MyTradesIMXEURPrivateChannel:   "private-my_trades_imxeur",
 		LiveTradesIMXUSDChannel:        "live_trades_imxusd",
 		LiveOrdersIMXUSDChannel:        "live_orders_imxusd",
 		OrderBookIMXUSDChannel:         "order_book_imxusd",
 		DetailOrderBookIMXUSDChannel:   "detail_order_book_imxusd",
 		DiffOrderBookIMXUSDChannel:     "diff_order_book_imxusd",
+		MyOrdersIMXUSDPrivateChannel:   "private-my_orders_imxusd",
+		MyTradesIMXUSDPrivateChannel:   "private-my_trades_imxusd",
 		LiveTradesINJEURChannel:        "live_trades_injeur",
 		LiveOrdersINJEURChannel:        "live_orders_injeur",
 		OrderBookINJEURChannel:         "order_book_injeur",
 		DetailOrderBookINJEURChannel:   "detail_order_book_injeur",
 		DiffOrderBookINJEURChannel:     "diff_order_book_injeur",
+		MyOrdersINJEURPrivateChannel:   "private-my_orders_injeur",
+		MyTradesINJEURPrivateChannel:   "private-my_trades_injeur",
 		LiveTradesINJUSDChannel:        "live_trades_injusd",
 		LiveOrdersINJUSDChannel:        "live_orders_injusd",
 		OrderBookINJUSDChannel:         "order_book_injusd",
 		DetailOrderBookINJUSDChannel:   "detail_order_book_injusd",
 		DiffOrderBookINJUSDChannel:     "diff_order_book_injusd",
+		MyOrdersINJUSDPrivateChannel:   "private-my_orders_injusd",
+		MyTradesINJUSDPrivateChannel:   "private-my_trades_injusd",
 		LiveTradesKNCBTCChannel:        "live_trades_kncbtc",
 		LiveOrdersKNCBTCChannel:        "live_orders_kncbtc",
 		OrderBookKNCBTCChannel:         "order_book_kncbtc",
 		DetailOrderBookKNCBTCChannel:   "detail_order_book_kncbtc",
 		DiffOrderBookKNCBTCChannel:     "diff_order_book_kncbtc",
+		MyOrdersKNCBTCPrivateChannel:   "private-my_orders_kncbtc",
+		MyTradesKNCBTCPrivateChannel:   "private-my_trades_kncbtc",
 		LiveTradesKNCEURChannel:        "live_trades_knceur",
 		LiveOrdersKNCEURChannel:        "live_orders_knceur",
 		OrderBookKNCEURChannel:         "order_book_knceur",
 		DetailOrderBookKNCEURChannel:   "detail_order_book_knceur",
 		DiffOrderBookKNCEURChannel:     "diff_order_book_knceur",
+		MyOrdersKNCEURPrivateChannel:   "private-my_orders_knceur",
+		MyTradesKNCEURPrivateChannel:   "private-my_trades_knceur",
 		LiveTradesKNCUSDChannel:        "live_trades_kncusd",
 		LiveOrdersKNCUSDChannel:        "live_orders_kncusd",
 		OrderBookKNCUSDChannel:         "order_book_kncusd",
 		DetailOrderBookKNCUSDChannel:   "detail_order_book_kncusd",
 		DiffOrderBookKNCUSDChannel:     "diff_order_book_kncusd",
+		MyOrdersKNCUSDPrivateChannel:   "private-my_orders_kncusd",
+		MyTradesKNCUSDPrivateChannel:   "private-my_trades_kncusd",
 		LiveTradesLINKBTCChannel:       "live_trades_linkbtc",
 		LiveOrdersLINKBTCChannel:       "live_orders_linkbtc",
 		OrderBookLINKBTCChannel:        "order_book_linkbtc",
 		DetailOrderBookLINKBTCChannel:  "detail_order_book_linkbtc",
 		DiffOrderBookLINKBTCChannel:    "diff_order_book_linkbtc",
+		MyOrdersLINKBTCPrivateChannel:  "private-my_orders_linkbtc",
+		MyTradesLINKBTCPrivateChannel:  "private-my_trades_linkbtc",
 		LiveTradesLINKETHChannel:       "live_trades_linketh",
 		LiveOrdersLINKETHChannel:       "live_orders_linketh",
 		OrderBookLINKETHChannel:        "order_book_linketh",
 		DetailOrderBookLINKETHChannel:  "detail_order_book_linketh",
 		DiffOrderBookLINKETHChannel:    "diff_order_book_linketh",
+		MyOrdersLINKETHPrivateChannel:  "private-my_orders_linketh",
+		MyTradesLINKETHPrivateChannel:  "private-my_trades_linketh",
 		LiveTradesLINKEURChannel:       "live_trades_linkeur",
 		LiveOrdersLINKEURChannel:       "live_orders_linkeur",
 		OrderBookLINKEURChannel:        "order_book_linkeur",
 		DetailOrderBookLINKEURChannel:  "detail_order_book_linkeur",
 		DiffOrderBookLINKEURChannel:    "diff_order_book_linkeur",
+		MyOrdersLINKEURPrivateChannel:  "private-my_orders_linkeur",
+		MyTradesLINKEURPrivateChannel:  "private-my_trades_linkeur",
 		LiveTradesLINKGBPChannel:       "live_trades_linkgbp",
 		LiveOrdersLINKGBPChannel:       "live_orders_linkgbp",
 		OrderBookLINKGBPChannel:        "order_book_linkgbp",
 		DetailOrderBookLINKGBPChannel:  "detail_order_book_linkgbp",
 		DiffOrderBookLINKGBPChannel:    "diff_order_book_linkgbp",
+		MyOrdersLINKGBPPrivateChannel:  "private-my_orders_linkgbp",
+		MyTradesLINKGBPPrivateChannel:  "private-my_trades_linkgbp",
 		LiveTradesLINKUSDChannel:       "live_trades_linkusd",
 		LiveOrdersLINKUSDChannel:       "live_orders_linkusd",
 		OrderBookLINKUSDChannel:        "order_book_linkusd",
 		DetailOrderBookLINKUSDChannel:  "detail_order_book_linkusd",
 		DiffOrderBookLINKUSDChannel:    "diff_order_book_linkusd",
+		MyOrdersLINKUSDPrivateChannel:  "private-my_orders_linkusd",
+		MyTradesLINKUSDPrivateChannel:  "private-my_trades_linkusd",
 		LiveTradesLTCBTCChannel:        "live_trades_ltcbtc",
 		LiveOrdersLTCBTCChannel:        "live_orders_ltcbtc",
 		OrderBookLTCBTCChannel:         "order_book_ltcbtc",
 		DetailOrderBookLTCBTCChannel:   "detail_order_book_ltcbtc",
 		DiffOrderBookLTCBTCChannel:     "diff_order_book_ltcbtc",
+		MyOrdersLTCBTCPrivateChannel:   "private-my_orders_ltcbtc",
+		MyTradesLTCBTCPrivateChannel:   "private-my_trades_ltcbtc",
 		LiveTradesLTCEURChannel:        "live_trades_ltceur",
 		LiveOrdersLTCEURChannel:        "live_orders_ltceur",
 		OrderBookLTCEURChannel:         "order_book_ltceur",
 		DetailOrderBookLTCEURChannel:   "detail_order_book_ltceur",
 		DiffOrderBookLTCEURChannel:     "diff_order_book_ltceur",
+		MyOrdersLTCEURPrivateChannel:   "private-my_orders_ltceur",
+		MyTradesLTCEURPrivateChannel:   "private-my_trades_ltceur",
 		LiveTradesLTCGBPChannel:        "live_trades_ltcgbp",
 		LiveOrdersLTCGBPChannel:        "live_orders_ltcgbp",
 		OrderBookLTCGBPChannel:         "order_book_ltcgbp",
 		DetailOrderBookLTCGBPChannel:   "detail_order_book_ltcgbp",
 		DiffOrderBookLTCGBPChannel:     "diff_order_book_ltcgbp",
+		MyOrdersLTCGBPPrivateChannel:   "private-my_orders_ltcgbp",
+		MyTradesLTCGBPPrivateChannel:   "private-my_trades_ltcgbp",
 		LiveTradesLTCUSDChannel:        "live_trades_ltcusd",
 		LiveOrdersLTCUSDChannel:        "live_orders_ltcusd",
 		OrderBookLTCUSDChannel:         "order_book_ltcusd",
 		DetailOrderBookLTCUSDChannel:   "detail_order_book_ltcusd",
 		DiffOrderBookLTCUSDChannel:     "diff_order_book_ltcusd",
+		MyOrdersLTCUSDPrivateChannel:   "private-my_orders_ltcusd",
+		MyTradesLTCUSDPrivateChannel:   "private-my_trades_ltcusd",
 		LiveTradesMATICEURChannel:      "live_trades_maticeur",
 		LiveOrdersMATICEURChannel:      "live_orders_maticeur",
 		OrderBookMATICEURChannel:       "order_book_maticeur",
 		DetailOrderBookMATICEURChannel: "detail_order_book_maticeur",
 		DiffOrderBookMATICEURChannel:   "diff_order_book_maticeur",
+		MyOrdersMATICEURPrivateChannel: "private-my_orders_maticeur",
+		MyTradesMATICEURPrivateChannel: "private-my_trades_maticeur",
 		LiveTradesMATICUSDChannel:      "live_trades_maticusd",
 		LiveOrdersMATICUSDChannel:      "live_orders_maticusd",
 		OrderBookMATICUSDChannel:       "order_book_maticusd",
 		DetailOrderBookMATICUSDChannel: "detail_order_book_maticusd",
 		DiffOrderBookMATICUSDChannel:   "diff_order_book_maticusd",
+		MyOrdersMATICUSDPrivateChannel: "private-my_orders_maticusd",
+		MyTradesMATICUSDPrivateChannel: "private-my_trades_maticusd",
 		LiveTradesMKRBTCChannel:        "live_trades_mkrbtc",
 		LiveOrdersMKRBTCChannel:        "live_orders_mkrbtc",
 		OrderBookMKRBTCChannel:         "order_book_mkrbtc",
 		DetailOrderBookMKRBTCChannel:   "detail_order_book_mkrbtc",
 		DiffOrderBookMKRBTCChannel:     "diff_order_book_mkrbtc",
+		MyOrdersMKRBTCPrivateChannel:   "private-my_orders_mkrbtc",
+		MyTradesMKRBTCPrivateChannel:   "private-my_trades_mkrbtc",
 		LiveTradesMKREURChannel:        "live_trades_mkreur",
 		LiveOrdersMKREURChannel:        "live_orders_mkreur",
 		OrderBookMKREURChannel:         "order_book_mkreur",
 		DetailOrderBookMKREURChannel:   "detail_order_book_mkreur",
 		DiffOrderBookMKREURChannel:     "diff_order_book_mkreur",
+		MyOrdersMKREURPrivateChannel:   "private-my_orders_mkreur",
+		MyTradesMKREURPrivateChannel:   "private-my_trades_mkreur",
 		LiveTradesMKRUSDChannel:        "live_trades_mkrusd",
 		LiveOrdersMKRUSDChannel:        "live_orders_mkrusd",
 		OrderBookMKRUSDChannel:         "order_book_mkrusd",
 		DetailOrderBookMKRUSDChannel:   "detail_order_book_mkrusd",
 		DiffOrderBookMKRUSDChannel:     "diff_order_book_mkrusd",
+		MyOrdersMKRUSDPrivateChannel:   "private-my_orders_mkrusd",
+		MyTradesMKRUSDPrivateChannel:   "private-my_trades_mkrusd",
 		LiveTradesNEXOEURChannel:       "live_trades_nexoeur",
 		LiveOrdersNEXOEURChannel:       "live_orders_nexoeur",
 		OrderBookNEXOEURChannel:        "order_book_nexoeur",
 		DetailOrderBookNEXOEURChannel:  "detail_order_book_nexoeur",
 		DiffOrderBookNEXOEURChannel:    "diff_order_book_nexoeur",
+		MyOrdersNEXOEURPrivateChannel:  "private-my_orders_nexoeur",
+		MyTradesNEXOEURPrivateChannel:  "private-my_trades_nexoeur",
 		LiveTradesNEXOUSDChannel:       "live_trades_nexousd",
 		LiveOrdersNEXOUSDChannel:       "live_orders_nexousd",
 		OrderBookNEXOUSDChannel:        "order_book_nexousd",
 		DetailOrderBookNEXOUSDChannel:  "detail_order_book_nexousd",
 		DiffOrderBookNEXOUSDChannel:    "diff_order_book_nexousd",
+		MyOrdersNEXOUSDPrivateChannel:  "private-my_orders_nexousd",
+		MyTradesNEXOUSDPrivateChannel:  "private-my_trades_nexousd",
 		LiveTradesOMGBTCChannel:        "live_trades_omgbtc",
 		LiveOrdersOMGBTCChannel:        "live_orders_omgbtc",
 		OrderBookOMGBTCChannel:         "order_book_omgbtc",
 		DetailOrderBookOMGBTCChannel:   "detail_order_book_omgbtc",
 		DiffOrderBookOMGBTCChannel:     "diff_order_book_omgbtc",
+		MyOrdersOMGBTCPrivateChannel:   "private-my_orders_omgbtc",
+		MyTradesOMGBTCPrivateChannel:   "private-my_trades_omgbtc",
 		LiveTradesOMGEURChannel:        "live_trades_omgeur",
 		LiveOrdersOMGEURChannel:        "live_orders_omgeur",
 		OrderBookOMGEURChannel:         "order_book_omgeur",
 		DetailOrderBookOMGEURChannel:   "detail_order_book_omgeur",
 		DiffOrderBookOMGEURChannel:     "diff_order_book_omgeur",
+		MyOrdersOMGEURPrivateChannel:   "private-my_orders_omgeur",
+		MyTradesOMGEURPrivateChannel:   "private-my_trades_omgeur",
 		LiveTradesOMGGBPChannel:        "live_trades_omggbp",
 		LiveOrdersOMGGBPChannel:        "live_orders_omggbp",
 		OrderBookOMGGBPChannel:         "order_book_omggbp",
 		DetailOrderBookOMGGBPChannel:   "detail_order_book_omggbp",
 		DiffOrderBookOMGGBPChannel:     "diff_order_book_omggbp",
+		MyOrdersOMGGBPPrivateChannel:   "private-my_orders_omggbp",
+		MyTradesOMGGBPPrivateChannel:   "private-my_trades_omggbp",
 		LiveTradesOMGUSDChannel:        "live_trades_omgusd",
 		LiveOrdersOMGUSDChannel:        "live_orders_omgusd",
 		OrderBookOMGUSDChannel:         "order_book_omgusd",
 		DetailOrderBookOMGUSDChannel:   "detail_order_book_omgusd",
 		DiffOrderBookOMGUSDChannel:     "diff_order_book_omgusd",
+		MyOrdersOMGUSDPrivateChannel:   "private-my_orders_omgusd",
+		MyTradesOMGUSDPrivateChannel:   "private-my_trades_omgusd",
 		LiveTradesPAXEURChannel:        "live_trades_paxeur",
 		LiveOrdersPAXEURChannel:        "live_orders_paxeur",
 		OrderBookPAXEURChannel:         "order_book_paxeur",
 		DetailOrderBookPAXEURChannel:   "detail_order_book_paxeur",
 		DiffOrderBookPAXEURChannel:     "diff_order_book_paxeur",
+		MyOrdersPAXEURPrivateChannel:   "private-my_orders_paxeur",
+		MyTradesPAXEURPrivateChannel:   "private-my_trades_paxeur",
 		LiveTradesPAXGBPChannel:        "live_trades_paxgbp",
 		LiveOrdersPAXGBPChannel:        "live_orders_paxgbp",
 		OrderBookPAXGBPChannel:         "order_book_paxgbp",
 		DetailOrderBookPAXGBPChannel:   "detail_order_book_paxgbp",
 		DiffOrderBookPAXGBPChannel:     "diff_order_book_paxgbp",
+		MyOrdersPAXGBPPrivateChannel:   "private-my_orders_paxgbp",
+		MyTradesPAXGBPPrivateChannel:   "private-my_trades_paxgbp",
 		LiveTradesPAXUSDChannel:        "live_trades_paxusd",
 		LiveOrdersPAXUSDChannel:        "live_orders_paxusd",
 		OrderBookPAXUSDChannel:         "order_book_paxusd",
 		DetailOrderBookPAXUSDChannel:   "detail_order_book_paxusd",
 		DiffOrderBookPAXUSDChannel:     "diff_order_book_paxusd",
+		MyOrdersPAXUSDPrivateChannel:   "private-my_orders_paxusd",
+		MyTradesPAXUSDPrivateChannel:   "private-my_trades_paxusd",
 		LiveTradesPERPEURChannel:       "live_trades_perpeur",
 		LiveOrdersPERPEURChannel:       "live_orders_perpeur",
 		OrderBookPERPEURChannel:        "order_book_perpeur",
 		DetailOrderBookPERPEURChannel:  "detail_order_book_perpeur",
 		DiffOrderBookPERPEURChannel:    "diff_order_book_perpeur",
+		MyOrdersPERPEURPrivateChannel:  "private-my_orders_perpeur",
+		MyTradesPERPEURPrivateChannel:  "private-my_trades_perpeur",
 		LiveTradesPERPUSDChannel:       "live_trades_perpusd",
 		LiveOrdersPERPUSDChannel:       "live_orders_perpusd",
 		OrderBookPERPUSDChannel:        "order_book_perpusd",
 		DetailOrderBookPERPUSDChannel:  "detail_order_book_perpusd",
 		DiffOrderBookPERPUSDChannel:    "diff_order_book_perpusd",
+		MyOrdersPERPUSDPrivateChannel:  "private-my_orders_perpusd",
+		MyTradesPERPUSDPrivateChannel:  "private-my_trades_perpusd",
 		LiveTradesRADEURChannel:        "live_trades_radeur",
 		LiveOrdersRADEURChannel:        "live_orders_radeur",
 		OrderBookRADEURChannel:         "order_book_radeur",
 		DetailOrderBookRADEURChannel:   "detail_order_book_radeur",
 		DiffOrderBookRADEURChannel:     "diff_order_book_radeur",
+		MyOrdersRADEURPrivateChannel:   "private-my_orders_radeur",
+		MyTradesRADEURPrivateChannel:   "private-my_trades_radeur",
 		LiveTradesRADUSDChannel:        "live_trades_radusd",
 		LiveOrdersRADUSDChannel:        "live_orders_radusd",
 		OrderBookRADUSDChannel:         "order_book_radusd",
 		DetailOrderBookRADUSDChannel:   "detail_order_book_radusd",
 		DiffOrderBookRADUSDChannel:     "diff_order_book_radusd",
+		MyOrdersRADUSDPrivateChannel:   "private-my_orders_radusd",
+		MyTradesRADUSDPrivateChannel:   "private-my_trades_radusd",
 		LiveTradesRGTEURChannel:        "live_trades_rgteur",
 		LiveOrdersRGTEURChannel:        "live_orders_rgteur",
 		OrderBookRGTEURChannel:         "order_book_rgteur",
 		DetailOrderBookRGTEURChannel:   "detail_order_book_rgteur",
 		DiffOrderBookRGTEURChannel:     "diff_order_book_rgteur",
+		MyOrdersRGTEURPrivateChannel:   "private-my_orders_rgteur",
+		MyTradesRGTEURPrivateChannel:   "private-my_trades_rgteur",
 		LiveTradesRGTUSDChannel:        "live_trades_rgtusd",
 		LiveOrdersRGTUSDChannel:        "live_orders_rgtusd",
 		OrderBookRGTUSDChannel:         "order_book_rgtusd",
 		DetailOrderBookRGTUSDChannel:   "detail_order_book_rgtusd",
 		DiffOrderBookRGTUSDChannel:     "diff_order_book_rgtusd",
+		MyOrdersRGTUSDPrivateChannel:   "private-my_orders_rgtusd",
+		MyTradesRGTUSDPrivateChannel:   "private-my_trades_rgtusd",
 		LiveTradesRLYEURChannel:        "live_trades_rlyeur",
 		LiveOrdersRLYEURChannel:        "live_orders_rlyeur",
 		OrderBookRLYEURChannel:         "order_book_rlyeur",
 		DetailOrderBookRLYEURChannel:   "detail_order_book_rlyeur",
 		DiffOrderBookRLYEURChannel:     "diff_order_book_rlyeur",
+		MyOrdersRLYEURPrivateChannel:   "private-my_orders_rlyeur",
+		MyTradesRLYEURPrivateChannel:   "private-my_trades_rlyeur",
 		LiveTradesRLYUSDChannel:        "live_trades_rlyusd",
 		LiveOrdersRLYUSDChannel:        "live_orders_rlyusd",
 		OrderBookRLYUSDChannel:         "order_book_rlyusd",
 		DetailOrderBookRLYUSDChannel:   "detail_order_book_rlyusd",
 		DiffOrderBookRLYUSDChannel:     "diff_order_book_rlyusd",
+		MyOrdersRLYUSDPrivateChannel:   "private-my_orders_rlyusd",
+		MyTradesRLYUSDPrivateChannel:   "private-my_trades_rlyusd",
 		LiveTradesRNDREURChannel:       "live_trades_rndreur",
 		LiveOrdersRNDREURChannel:       "live_orders_rndreur",
 		OrderBookRNDREURChannel:        "order_book_rndreur",
 		DetailOrderBookRNDREURChannel:  "detail_order_book_rndreur",
 		DiffOrderBookRNDREURChannel:    "diff_order_book_rndreur",
+		MyOrdersRNDREURPrivateChannel:  "private-my_orders_rndreur",
+		MyTradesRNDREURPrivateChannel:  "private-my_trades_rndreur",
 		LiveTradesRNDRUSDChannel:       "live_trades_rndrusd",
 		LiveOrdersRNDRUSDChannel:       "live_orders_rndrusd",
 		OrderBookRNDRUSDChannel:        "order_book_rndrusd",
 		DetailOrderBookRNDRUSDChannel:  "detail_order_book_rndrusd",
 		DiffOrderBookRNDRUSDChannel:    "diff_order_book_rndrusd",
+		MyOrdersRNDRUSDPrivateChannel:  "private-my_orders_rndrusd",
+		MyTradesRNDRUSDPrivateChannel:  "private-my_trades_rndrusd",
 		LiveTradesSANDEURChannel:       "live_trades_sandeur",
 		LiveOrdersSANDEURChannel:       "live_orders_sandeur",
 		OrderBookSANDEURChannel:        "order_book_sandeur",
 		DetailOrderBookSANDEURChannel:  "detail_order_book_sandeur",
 		DiffOrderBookSANDEURChannel:    "diff_order_book_sandeur",
+		MyOrdersSANDEURPrivateChannel:  "private-my_orders_sandeur",
+		MyTradesSANDEURPrivateChannel:  "private-my_trades_sandeur",
 		LiveTradesSANDUSDChannel:       "live_trades_sandusd",
 		LiveOrdersSANDUSDChannel:       "live_orders_sandusd",
 		OrderBookSANDUSDChannel:        "order_book_sandusd",
 		DetailOrderBookSANDUSDChannel:  "detail_order_book_sandusd",
 		DiffOrderBookSANDUSDChannel:    "diff_order_book_sandusd",
+		MyOrdersSANDUSDPrivateChannel:  "private-my_orders_sandusd",
+		MyTradesSANDUSDPrivateChannel:  "private-my_trades_sandusd",
 		LiveTradesSGBEURChannel:        "live_trades_sgbeur",
 		LiveOrdersSGBEURChannel:        "live_orders_sgbeur",
 		OrderBookSGBEURChannel:         "order_book_sgbeur",
 		DetailOrderBookSGBEURChannel:   "detail_order_book_sgbeur",
 		DiffOrderBookSGBEURChannel:     "diff_order_book_sgbeur",
+		MyOrdersSGBEURPrivateChannel:   "private-my_orders_sgbeur",
+		MyTradesSGBEURPrivateChannel:   "private-my_trades_sgbeur",
 		LiveTradesSGBUSDChannel:        "live_trades_sgbusd",
 		LiveOrdersSGBUSDChannel:        "live_orders_sgbusd",
 		OrderBookSGBUSDChannel:         "order_book_sgbusd",
 		DetailOrderBookSGBUSDChannel:   "detail_order_book_sgbusd",
 		DiffOrderBookSGBUSDChannel:     "diff_order_book_sgbusd",
+		MyOrdersSGBUSDPrivateChannel:   "private-my_orders_sgbusd",
+		MyTradesSGBUSDPrivateChannel:   "private-my_trades_sgbusd",
 		LiveTradesSKLEURChannel:        "live_trades_skleur",
 		LiveOrdersSKLEURChannel:        "live_orders_skleur",
 		OrderBookSKLEURChannel:         "order_book_skleur",
 		DetailOrderBookSKLEURChannel:   "detail_order_book_skleur",
 		DiffOrderBookSKLEURChannel:     "diff_order_book_skleur",
+		MyOrdersSKLEURPrivateChannel:   "private-my_orders_skleur",
+		MyTradesSKLEURPrivateChannel:   "private-my_trades_skleur",
 		LiveTradesSKLUSDChannel:        "live_trades_sklusd",
 		LiveOrdersSKLUSDChannel:        "live_orders_sklusd",
 		OrderBookSKLUSDChannel:         "order_book_sklusd",
 		DetailOrderBookSKLUSDChannel:   "detail_order_book_sklusd",
 		DiffOrderBookSKLUSDChannel:     "diff_order_book_sklusd",
+		MyOrdersSKLUSDPrivateChannel:   "private-my_orders_sklusd",
+		MyTradesSKLUSDPrivateChannel:   "private-my_trades_sklusd",
 		LiveTradesSLPEURChannel:        "live_trades_slpeur",
 		LiveOrdersSLPEURChannel:        "live_orders_slpeur",
 		OrderBookSLPEURChannel:         "order_book_slpeur",
 		DetailOrderBookSLPEURChannel:   "detail_order_book_slpeur",
 		DiffOrderBookSLPEURChannel:     "diff_order_book_slpeur",
+		MyOrdersSLPEURPrivateChannel:   "private-my_orders_slpeur",
+		MyTradesSLPEURPrivateChannel:   "private-my_trades_slpeur",
 		LiveTradesSLPUSDChannel:        "live_trades_slpusd",
 		LiveOrdersSLPUSDChannel:        "live_orders_slpusd",
 		OrderBookSLPUSDChannel:         "order_book_slpusd",
 		DetailOrderBookSLPUSDChannel:   "detail_order_book_slpusd",
 		DiffOrderBookSLPUSDChannel:     "diff_order_book_slpusd",
+		MyOrdersSLPUSDPrivateChannel:   "private-my_orders_slpusd",
+		MyTradesSLPUSDPrivateChannel:   "private-my_trades_slpusd",
 		LiveTradesSNXBTCChannel:        "live_trades_snxbtc",
 		LiveOrdersSNXBTCChannel:        "live_orders_snxbtc",
 		OrderBookSNXBTCChannel:         "order_book_snxbtc",
 		DetailOrderBookSNXBTCChannel:   "detail_order_book_snxbtc",
 		DiffOrderBookSNXBTCChannel:     "diff_order_book_snxbtc",
+		MyOrdersSNXBTCPrivateChannel:   "private-my_orders_snxbtc",
+		MyTradesSNXBTCPrivateChannel:   "private-my_trades_snxbtc",
 		LiveTradesSNXEURChannel:        "live_trades_snxeur",
 		LiveOrdersSNXEURChannel:        "live_orders_snxeur",
 		OrderBookSNXEURChannel:         "order_book_snxeur",
 		DetailOrderBookSNXEURChannel:   "detail_order_book_snxeur",
 		DiffOrderBookSNXEURChannel:     "diff_order_book_snxeur",
+		MyOrdersSNXEURPrivateChannel:   "private-my_orders_snxeur",
+		MyTradesSNXEURPrivateChannel:   "private-my_trades_snxeur",
 		LiveTradesSNXUSDChannel:        "live_trades_snxusd",
 		LiveOrdersSNXUSDChannel:        "live_orders_snxusd",
 		OrderBookSNXUSDChannel:         "order_book_snxusd",
 		DetailOrderBookSNXUSDChannel:   "detail_order_book_snxusd",
 		DiffOrderBookSNXUSDChannel:     "diff_order_book_snxusd",
+		MyOrdersSNXUSDPrivateChannel:   "private-my_orders_snxusd",
+		MyTradesSNXUSDPrivateChannel:   "private-my_trades_snxusd",
 		LiveTradesSTORJEURChannel:      "live_trades_storjeur",
 		LiveOrdersSTORJEURChannel:      "live_orders_storjeur",
 		OrderBookSTORJEURChannel:       "order_book_storjeur",
 		DetailOrderBookSTORJEURChannel: "detail_order_book_storjeur",
 		DiffOrderBookSTORJEURChannel:   "diff_order_book_storjeur",
+		MyOrdersSTORJEURPrivateChannel: "private-my_orders_storjeur",
+		MyTradesSTORJEURPrivateChannel: "private-my_trades_storjeur",
 		LiveTradesSTORJUSDChannel:      "live_trades_storjusd",
 		LiveOrdersSTORJUSDChannel:      "live_orders_storjusd",
 		OrderBookSTORJUSDChannel:       "order_book_storjusd",
 		DetailOrderBookSTORJUSDChannel: "detail_order_book_storjusd",
 		DiffOrderBookSTORJUSDChannel:   "diff_order_book_storjusd",
+		MyOrdersSTORJUSDPrivateChannel: "private-my_orders_storjusd",
+		MyTradesSTORJUSDPrivateChannel: "private-my_trades_storjusd",
 		LiveTradesSUSHIEURChannel:      "live_trades_sushieur",
 		LiveOrdersSUSHIEURChannel:      "live_orders_sushieur",
 		OrderBookSUSHIEURChannel:       "order_book_sushieur",
 		DetailOrderBookSUSHIEURChannel: "detail_order_book_sushieur",
 		DiffOrderBookSUSHIEURChannel:   "diff_order_book_sushieur",
+		MyOrdersSUSHIEURPrivateChannel: "private-my_orders_sushieur",
+		MyTradesSUSHIEURPrivateChannel: "private-my_trades_sushieur",
 		LiveTradesSUSHIUSDChannel:      "live_trades_sushiusd",
 		LiveOrdersSUSHIUSDChannel:      "live_orders_sushiusd",
 		OrderBookSUSHIUSDChannel:       "order_book_sushiusd",
 		DetailOrderBookSUSHIUSDChannel: "detail_order_book_sushiusd",
 		DiffOrderBookSUSHIUSDChannel:   "diff_order_book_sushiusd",
+		MyOrdersSUSHIUSDPrivateChannel: "private-my_orders_sushiusd",
+		MyTradesSUSHIUSDPrivateChannel: "private-my_trades_sushiusd",
 		LiveTradesSXPEURChannel:        "live_trades_sxpeur",
 		LiveOrdersSXPEURChannel:        "live_orders_sxpeur",
 		OrderBookSXPEURChannel:         "order_book_sxpeur",
 		DetailOrderBookSXPEURChannel:   "detail_order_book_sxpeur",
 		DiffOrderBookSXPEURChannel:     "diff_order_book_sxpeur",
+		MyOrdersSXPEURPrivateChannel:   "private-my_orders_sxpeur",
+		MyTradesSXPEURPrivateChannel:   "private-my_trades_sxpeur",
 		LiveTradesSXPUSDChannel:        "live_trades_sxpusd",
 		LiveOrdersSXPUSDChannel:        "live_orders_sxpusd",
 		OrderBookSXPUSDChannel:         "order_book_sxpusd",
 		DetailOrderBookSXPUSDChannel:   "detail_order_book_sxpusd",
 		DiffOrderBookSXPUSDChannel:     "diff_order_book_sxpusd",
+		MyOrdersSXPUSDPrivateChannel:   "private-my_orders_sxpusd",
+		MyTradesSXPUSDPrivateChannel:   "private-my_trades_sxpusd",
 		LiveTradesUMABTCChannel:        "live_trades_umabtc",
 		LiveOrdersUMABTCChannel:        "live_orders_umabtc",
 		OrderBookUMABTCChannel:         "order_book_umabtc",
 		DetailOrderBookUMABTCChannel:   "detail_order_book_umabtc",
 		DiffOrderBookUMABTCChannel:     "diff_order_book_umabtc",
+		MyOrdersUMABTCPrivateChannel:   "private-my_orders_umabtc",
+		MyTradesUMABTCPrivateChannel:   "private-my_trades_umabtc",
 		LiveTradesUMAEURChannel:        "live_trades_umaeur",
 		LiveOrdersUMAEURChannel:        "live_orders_umaeur",
 		OrderBookUMAEURChannel:         "order_book_umaeur",
 		DetailOrderBookUMAEURChannel:   "detail_order_book_umaeur",
 		DiffOrderBookUMAEURChannel:     "diff_order_book_umaeur",
+		MyOrdersUMAEURPrivateChannel:   "private-my_orders_umaeur",
+		MyTradesUMAEURPrivateChannel:   "private-my_trades_umaeur",
 		LiveTradesUMAUSDChannel:        "live_trades_umausd",
 		LiveOrdersUMAUSDChannel:        "live_orders_umausd",
 		OrderBookUMAUSDChannel:         "order_book_umausd",
 		DetailOrderBookUMAUSDChannel:   "detail_order_book_umausd",
 		DiffOrderBookUMAUSDChannel:     "diff_order_book_umausd",
+		MyOrdersUMAUSDPrivateChannel:   "private-my_orders_umausd",
+		MyTradesUMAUSDPrivateChannel:   "private-my_trades_umausd",
 		LiveTradesUNIBTCChannel:        "live_trades_unibtc",
 		LiveOrdersUNIBTCChannel:        "live_orders_unibtc",
 		OrderBookUNIBTCChannel:         "order_book_unibtc",
 		DetailOrderBookUNIBTCChannel:   "detail_order_book_unibtc",
 		DiffOrderBookUNIBTCChannel:     "diff_order_book_unibtc",
+		MyOrdersUNIBTCPrivateChannel:   "private-my_orders_unibtc",
+		MyTradesUNIBTCPrivateChannel:   "private-my_trades_unibtc",
 		LiveTradesUNIEURChannel:        "live_trades_unieur",
 		LiveOrdersUNIEURChannel:        "live_orders_unieur",
 		OrderBookUNIEURChannel:         "order_book_unieur",
 		DetailOrderBookUNIEURChannel:   "detail_order_book_unieur",
 		DiffOrderBookUNIEURChannel:     "diff_order_book_unieur",
+		MyOrdersUNIEURPrivateChannel:   "private-my_orders_unieur",
+		MyTradesUNIEURPrivateChannel:   "private-my_trades_unieur",
 		LiveTradesUNIUSDChannel:        "live_trades_uniusd",
 		LiveOrdersUNIUSDChannel:        "live_orders_uniusd",
 		OrderBookUNIUSDChannel:         "order_book_uniusd",
 		DetailOrderBookUNIUSDChannel:   "detail_order_book_uniusd",
 		DiffOrderBookUNIUSDChannel:     "diff_order_book_uniusd",
+		MyOrdersUNIUSDPrivateChannel:   "private-my_orders_uniusd",
+		MyTradesUNIUSDPrivateChannel:   "private-my_trades_uniusd",
 		LiveTradesUSDCEURChannel:       "live_trades_usdceur",
 		LiveOrdersUSDCEURChannel:       "live_orders_usdceur",
 		OrderBookUSDCEURChannel:        "order_book_usdceur",
 		DetailOrderBookUSDCEURChannel:  "detail_order_book_usdceur",
 		DiffOrderBookUSDCEURChannel:    "diff_order_book_usdceur",
+		MyOrdersUSDCEURPrivateChannel:  "private-my_orders_usdceur",
+		MyTradesUSDCEURPrivateChannel:  "private-my_trades_usdceur",
 		LiveTradesUSDCUSDChannel:       "live_trades_usdcusd",
 		LiveOrdersUSDCUSDChannel:       "live_orders_usdcusd",
 		OrderBookUSDCUSDChannel:        "order_book_usdcusd",
 		DetailOrderBookUSDCUSDChannel:  "detail_order_book_usdcusd",
 		DiffOrderBookUSDCUSDChannel:    "diff_order_book_usdcusd",
+		MyOrdersUSDCUSDPrivateChannel:  "private-my_orders_usdcusd",
+		MyTradesUSDCUSDPrivateChannel:  "private-my_trades_usdcusd",
 		LiveTradesUSDCUSDTChannel:      "live_trades_usdcusdt",
 		LiveOrdersUSDCUSDTChannel:      "live_orders_usdcusdt",
 		OrderBookUSDCUSDTChannel:       "order_book_usdcusdt",
 		DetailOrderBookUSDCUSDTChannel: "detail_order_book_usdcusdt",
 		DiffOrderBookUSDCUSDTChannel:   "diff_order_book_usdcusdt",
+		MyOrdersUSDCUSDTPrivateChannel: "private-my_orders_usdcusdt",
+		MyTradesUSDCUSDTPrivateChannel: "private-my_trades_usdcusdt",
 		LiveTradesUSDTEURChannel:       "live_trades_usdteur",
 		LiveOrdersUSDTEURChannel:       "live_orders_usdteur",
 		OrderBookUSDTEURChannel:        "order_book_usdteur",
 		DetailOrderBookUSDTEURChannel:  "detail_order_book_usdteur",
 		DiffOrderBookUSDTEURChannel:    "diff_order_book_usdteur",
+		MyOrdersUSDTEURPrivateChannel:  "private-my_orders_usdteur",
+		MyTradesUSDTEURPrivateChannel:  "private-my_trades_usdteur",
 		LiveTradesUSDTUSDChannel:       "live_trades_usdtusd",
 		LiveOrdersUSDTUSDChannel:       "live_orders_usdtusd",
 		OrderBookUSDTUSDChannel:        "order_book_usdtusd",
 		DetailOrderBookUSDTUSDChannel:  "detail_order_book_usdtusd",
 		DiffOrderBookUSDTUSDChannel:    "diff_order_book_usdtusd",
+		MyOrdersUSDTUSDPrivateChannel:  "private-my_orders_usdtusd",
+		MyTradesUSDTUSDPrivateChannel:  "private-my_trades_usdtusd",
 		LiveTradesUSTEURChannel:        "live_trades_usteur",
 		LiveOrdersUSTEURChannel:        "live_orders_usteur",
 		OrderBookUSTEURChannel:         "order_book_usteur",
 		DetailOrderBookUSTEURChannel:   "detail_order_book_usteur",
 		DiffOrderBookUSTEURChannel:     "diff_order_book_usteur",
+		MyOrdersUSTEURPrivateChannel:   "private-my_orders_usteur",
+		MyTradesUSTEURPrivateChannel:   "private-my_trades_usteur",
 		LiveTradesUSTUSDChannel:        "live_trades_ustusd",
 		LiveOrdersUSTUSDChannel:        "live_orders_ustusd",
 		OrderBookUSTUSDChannel:         "order_book_ustusd",
 		DetailOrderBookUSTUSDChannel:   "detail_order_book_ustusd",
 		DiffOrderBookUSTUSDChannel:     "diff_order_book_ustusd",
+		MyOrdersUSTUSDPrivateChannel:   "private-my_orders_ustusd",
+		MyTradesUSTUSDPrivateChannel:   "private-my_trades_ustusd",
 		LiveTradesVEGAEURChannel:       "live_trades_vegaeur",
 		LiveOrdersVEGAEURChannel:       "live_orders_vegaeur",
 		OrderBookVEGAEURChannel:        "order_book_vegaeur",
 		DetailOrderBookVEGAEURChannel:  "detail_order_book_vegaeur",
 		DiffOrderBookVEGAEURChannel:    "diff_order_book_vegaeur",
+		MyOrdersVEGAEURPrivateChannel:  "private-my_orders_vegaeur",
+		MyTradesVEGAEURPrivateChannel:  "private-my_trades_vegaeur",
 		LiveTradesVEGAUSDChannel:       "live_trades_vegausd",
 		LiveOrdersVEGAUSDChannel:       "live_orders_vegausd",
 		OrderBookVEGAUSDChannel:        "order_book_vegausd",
 		DetailOrderBookVEGAUSDChannel:  "detail_order_book_vegausd",
 		DiffOrderBookVEGAUSDChannel:    "diff_order_book_vegausd",
+		MyOrdersVEGAUSDPrivateChannel:  "private-my_orders_vegausd",
+		MyTradesVEGAUSDPrivateChannel:  "private-my_trades_vegausd",
 		LiveTradesWBTCBTCChannel:       "live_trades_wbtcbtc",
 		LiveOrdersWBTCBTCChannel:       "live_orders_wbtcbtc",
 		OrderBookWBTCBTCChannel:        "order_book_wbtcbtc",
 		DetailOrderBookWBTCBTCChannel:  "detail_order_book_wbtcbtc",
 		DiffOrderBookWBTCBTCChannel:    "diff_order_book_wbtcbtc",
+		MyOrdersWBTCBTCPrivateChannel:  "private-my_orders_wbtcbtc",
+		MyTradesWBTCBTCPrivateChannel:  "private-my_trades_wbtcbtc",
 		LiveTradesXLMBTCChannel:        "live_trades_xlmbtc",
 		LiveOrdersXLMBTCChannel:        "live_orders_xlmbtc",
 		OrderBookXLMBTCChannel:         "order_book_xlmbtc",
 		DetailOrderBookXLMBTCChannel:   "detail_order_book_xlmbtc",
 		DiffOrderBookXLMBTCChannel:     "diff_order_book_xlmbtc",
+		MyOrdersXLMBTCPrivateChannel:   "private-my_orders_xlmbtc",
+		MyTradesXLMBTCPrivateChannel:   "private-my_trades_xlmbtc",
 		LiveTradesXLMEURChannel:        "live_trades_xlmeur",
 		LiveOrdersXLMEURChannel:        "live_orders_xlmeur",
 		OrderBookXLMEURChannel:         "order_book_xlmeur",
 		DetailOrderBookXLMEURChannel:   "detail_order_book_xlmeur",
 		DiffOrderBookXLMEURChannel:     "diff_order_book_xlmeur",
+		MyOrdersXLMEURPrivateChannel:   "private-my_orders_xlmeur",
+		MyTradesXLMEURPrivateChannel:   "private-my_trades_xlmeur",
 		LiveTradesXLMGBPChannel:        "live_trades_xlmgbp",
 		LiveOrdersXLMGBPChannel:        "live_orders_xlmgbp",
 		OrderBookXLMGBPChannel:         "order_book_xlmgbp",
 		DetailOrderBookXLMGBPChannel:   "detail_order_book_xlmgbp",
 		DiffOrderBookXLMGBPChannel:     "diff_order_book_xlmgbp",
+		MyOrdersXLMGBPPrivateChannel:   "private-my_orders_xlmgbp",
+		MyTradesXLMGBPPrivateChannel:   "private-my_trades_xlmgbp",
 		LiveTradesXLMUSDChannel:        "live_trades_xlmusd",
 		LiveOrdersXLMUSDChannel:        "live_orders_xlmusd",
 		OrderBookXLMUSDChannel:         "order_book_xlmusd",
 		DetailOrderBookXLMUSDChannel:   "detail_order_book_xlmusd",
 		DiffOrderBookXLMUSDChannel:     "diff_order_book_xlmusd",
+		MyOrdersXLMUSDPrivateChannel:   "private-my_orders_xlmusd",
+		MyTradesXLMUSDPrivateChannel:   "private-my_trades_xlmusd",
 		LiveTradesXRPBTCChannel:        "live_trades_xrpbtc",
 		LiveOrdersXRPBTCChannel:        "live_orders_xrpbtc",
 		OrderBookXRPBTCChannel:         "order_book_xrpbtc",
 		DetailOrderBookXRPBTCChannel:   "detail_order_book_xrpbtc",
 		DiffOrderBookXRPBTCChannel:     "diff_order_book_xrpbtc",
+		MyOrdersXRPBTCPrivateChannel:   "private-my_orders_xrpbtc",
+		MyTradesXRPBTCPrivateChannel:   "private-my_trades_xrpbtc",
 		LiveTradesXRPEURChannel:        "live_trades_xrpeur",
 		LiveOrdersXRPEURChannel:        "live_orders_xrpeur",
 		OrderBookXRPEURChannel:         "order_book_xrpeur",
 		DetailOrderBookXRPEURChannel:   "detail_order_book_xrpeur",
 		DiffOrderBookXRPEURChannel:     "diff_order_book_xrpeur",
+		MyOrdersXRPEURPrivateChannel:   "private-my_orders_xrpeur",
+		MyTradesXRPEURPrivateChannel:   "private-my_trades_xrpeur",
 		LiveTradesXRPGBPChannel:        "live_trades_xrpgbp",
 		LiveOrdersXRPGBPChannel:        "live_orders_xrpgbp",
 		OrderBookXRPGBPChannel:         "order_book_xrpgbp",
 		DetailOrderBookXRPGBPChannel:   "detail_order_book_xrpgbp",
 		DiffOrderBookXRPGBPChannel:     "diff_order_book_xrpgbp",
+		MyOrdersXRPGBPPrivateChannel:   "private-my_orders_xrpgbp",
+		MyTradesXRPGBPPrivateChannel:   "private-my_trades_xrpgbp",
 		LiveTradesXRPPAXChannel:        "live_trades_xrppax",
 		LiveOrdersXRPPAXChannel:        "live_orders_xrppax",
 		OrderBookXRPPAXChannel:         "order_book_xrppax",
 		DetailOrderBookXRPPAXChannel:   "detail_order_book_xrppax",
 		DiffOrderBookXRPPAXChannel:     "diff_order_book_xrppax",
+		MyOrdersXRPPAXPrivateChannel:   "private-my_orders_xrppax",
+		MyTradesXRPPAXPrivateChannel:   "private-my_trades_xrppax",
 		LiveTradesXRPUSDChannel:        "live_trades_xrpusd",
 		LiveOrdersXRPUSDChannel:        "live_orders_xrpusd",
 		OrderBookXRPUSDChannel:         "order_book_xrpusd",
 		DetailOrderBookXRPUSDChannel:   "detail_order_book_xrpusd",
 		DiffOrderBookXRPUSDChannel:     "diff_order_book_xrpusd",
+		MyOrdersXRPUSDPrivateChannel:   "private-my_orders_xrpusd",
+		MyTradesXRPUSDPrivateChannel:   "private-my_trades_xrpusd",
 		LiveTradesXRPUSDTChannel:       "live_trades_xrpusdt",
 		LiveOrdersXRPUSDTChannel:       "live_orders_xrpusdt",
 		OrderBookXRPUSDTChannel:        "order_book_xrpusdt",
 		DetailOrderBookXRPUSDTChannel:  "detail_order_book_xrpusdt",
 		DiffOrderBookXRPUSDTChannel:    "diff_order_book_xrpusdt",
+		MyOrdersXRPUSDTPrivateChannel:  "private-my_orders_xrpusdt",
+		MyTradesXRPUSDTPrivateChannel:  "private-my_trades_xrpusdt",
 		LiveTradesYFIBTCChannel:        "live_trades_yfibtc",
 		LiveOrdersYFIBTCChannel:        "live_orders_yfibtc",
 		OrderBookYFIBTCChannel:         "order_book_yfibtc",
 		DetailOrderBookYFIBTCChannel:   "detail_order_book_yfibtc",
 		DiffOrderBookYFIBTCChannel:     "diff_order_book_yfibtc",
+		MyOrdersYFIBTCPrivateChannel:   "private-my_orders_yfibtc",
+		MyTradesYFIBTCPrivateChannel:   "private-my_trades_yfibtc",
 		LiveTradesYFIEURChannel:        "live_trades_yfieur",
 		LiveOrdersYFIEURChannel:        "live_orders_yfieur",
 		OrderBookYFIEURChannel:         "order_book_yfieur",
 		DetailOrderBookYFIEURChannel:   "detail_order_book_yfieur",
 		DiffOrderBookYFIEURChannel:     "diff_order_book_yfieur",
+		MyOrdersYFIEURPrivateChannel:   "private-my_orders_yfieur",
+		MyTradesYFIEURPrivateChannel:   "private-my_trades_yfieur",
 		LiveTradesYFIUSDChannel:        "live_trades_yfiusd",
 		LiveOrdersYFIUSDChannel:        "live_orders_yfiusd",
 		OrderBookYFIUSDChannel:         "order_book_yfiusd",
 		DetailOrderBookYFIUSDChannel:   "detail_order_book_yfiusd",
 		DiffOrderBookYFIUSDChannel:     "diff_order_book_yfiusd",
+		MyOrdersYFIUSDPrivateChannel:   "private-my_orders_yfiusd",
+		MyTradesYFIUSDPrivateChannel:   "private-my_trades_yfiusd",
 		LiveTradesZRXBTCChannel:        "live_trades_zrxbtc",
 		LiveOrdersZRXBTCChannel:        "live_orders_zrxbtc",
 		OrderBookZRXBTCChannel:         "order_book_zrxbtc",
 		DetailOrderBookZRXBTCChannel:   "detail_order_book_zrxbtc",
 		DiffOrderBookZRXBTCChannel:     "diff_order_book_zrxbtc",
+		MyOrdersZRXBTCPrivateChannel:   "private-my_orders_zrxbtc",
+		MyTradesZRXBTCPrivateChannel:   "private-my_trades_zrxbtc",
 		LiveTradesZRXEURChannel:        "live_trades_zrxeur",
 		LiveOrdersZRXEURChannel:        "live_orders_zrxeur",
 		OrderBookZRXEURChannel:         "order_book_zrxeur",
 		DetailOrderBookZRXEURChannel:   "detail_order_book_zrxeur",
 		DiffOrderBookZRXEURChannel:     "diff_order_book_zrxeur",
+		MyOrdersZRXEURPrivateChannel:   "private-my_orders_zrxeur",
+		MyTradesZRXEURPrivateChannel:   "private-my_trades_zrxeur",
 		LiveTradesZRXUSDChannel:        "live_trades_zrxusd",
 		LiveOrdersZRXUSDChannel:        "live_orders_zrxusd",
 		OrderBookZRXUSDChannel:         "order_book_zrxusd",
 		DetailOrderBookZRXUSDChannel:   "detail_order_book_zrxusd",
 		DiffOrderBookZRXUSDChannel:     "diff_order_book_zrxusd",
+		MyOrdersZRXUSDPrivateChannel:   "private-my_orders_zrxusd",
+		MyTradesZRXUSDPrivateChannel:   "private-my_trades_zrxusd",
 	}
 }
@@ -0,0 +1,74 @@
+package bitstamp
+
+import (
+	"sort"
+	"strings"
+)
+
+// Currency a currency code as used by bitstamp (btc, eur, xrp etc)
+type Currency string
+
+// quoteCurrencies known counter currencies, longer codes first so that usdc/usdt are matched before usd
+var quoteCurrencies = []Currency{"usdc", "usdt", "pax", "eur", "usd", "gbp", "btc", "eth"}
+
+// knownCurrencies currencies returned by GetAllCurrencies
+var knownCurrencies = func() map[Currency]struct{} {
+	set := make(map[Currency]struct{})
+	for _, c := range GetAllCurrencies() {
+		set[c] = struct{}{}
+	}
+
+	return set
+}()
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+// Base returns the base currency of a pair (Example: For BTC/USD pair, base currency is BTC)
+func (p Pair) Base() Currency {
+	base, _ := splitPair(p)
+	return base
+}
+
+// Quote returns the counter currency of a pair (Example: For BTC/USD pair, counter currency is USD)
+func (p Pair) Quote() Currency {
+	_, quote := splitPair(p)
+	return quote
+}
+
+// GetAllCurrencies returns all currencies that take part in at least one of the supported pairs
+func GetAllCurrencies() []Currency {
+	set := make(map[Currency]struct{})
+
+	for p := range getPairs() {
+		base, quote := splitPair(p)
+		if base == "" || quote == "" {
+			continue
+		}
+		set[base] = struct{}{}
+		set[quote] = struct{}{}
+	}
+
+	result := make([]Currency, 0, len(set))
+	for c := range set {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+func splitPair(p Pair) (Currency, Currency) {
+	s := p.String()
+
+	for _, q := range quoteCurrencies {
+		if strings.HasSuffix(s, string(q)) && len(s) > len(q) {
+			return Currency(strings.TrimSuffix(s, string(q))), q
+		}
+	}
+
+	return "", ""
+}
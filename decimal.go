@@ -0,0 +1,287 @@
+package bitstamp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidDecimal = errors.New("invalid decimal")
+
+// maxDecimalScale bounds exponents and decimal places accepted by NewDecimal, it keeps the scale within int32 and
+// stops inputs like "1e2000000000" from allocating huge numbers
+const maxDecimalScale = 1000
+
+// Decimal an arbitrary precision decimal number used for prices and amounts, zero value is ready to use and equals 0
+//
+// A decimal is represented as value * 10^-scale, all operations return new decimals so a Decimal can be safely
+// copied and shared.
+type Decimal struct {
+	value *big.Int
+	scale int32
+}
+
+// NewDecimal parses a decimal from its text representation (Example: "52261.99", "-0.5", "1e-8")
+func NewDecimal(s string) (Decimal, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, fmt.Errorf("%w, empty string", ErrInvalidDecimal)
+	}
+
+	var exp int64
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("%w `%s`, %s", ErrInvalidDecimal, orig, err)
+		}
+		if e > maxDecimalScale || e < -maxDecimalScale {
+			return Decimal{}, fmt.Errorf("%w `%s`, exponent out of range", ErrInvalidDecimal, orig)
+		}
+		exp = e
+		s = s[:i]
+	}
+
+	var scale int64
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		scale = int64(len(s) - i - 1)
+		s = s[:i] + s[i+1:]
+	}
+
+	// reject inputs that big.Int accepts but are not decimals like hex or underscore separated numbers
+	digits := strings.TrimLeft(s, "+-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" || len(s)-len(digits) > 1 {
+		return Decimal{}, fmt.Errorf("%w `%s`", ErrInvalidDecimal, orig)
+	}
+
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w `%s`", ErrInvalidDecimal, orig)
+	}
+
+	scale -= exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w `%s`, scale out of range", ErrInvalidDecimal, orig)
+	}
+	if scale < 0 {
+		value.Mul(value, pow10(int32(-scale)))
+		scale = 0
+	}
+
+	return Decimal{value: value, scale: int32(scale)}, nil
+}
+
+// MustDecimal same as NewDecimal but panics if string is not a valid decimal, use it for constants
+func MustDecimal(s string) Decimal {
+	d, err := NewDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// NewDecimalFromInt creates a decimal from an integer
+func NewDecimalFromInt(i int64) Decimal {
+	return Decimal{value: big.NewInt(i)}
+}
+
+// String returns the decimal as text keeping trailing zeros (Example: "0.25000000")
+func (d Decimal) String() string {
+	v := d.bigInt()
+	if d.scale == 0 {
+		return v.String()
+	}
+
+	digits := new(big.Int).Abs(v).String()
+	if pad := int(d.scale) - len(digits) + 1; pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+
+	sign := ""
+	if v.Sign() < 0 {
+		sign = "-"
+	}
+
+	i := len(digits) - int(d.scale)
+
+	return sign + digits[:i] + "." + digits[i:]
+}
+
+// Scale returns the number of digits after the decimal point
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Float64 returns the nearest float64 value, use it only for display or statistics
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.bigInt(), pow10(d.scale)).Float64()
+	return f
+}
+
+// Sign returns -1 if d < 0, 0 if d == 0 and +1 if d > 0
+func (d Decimal) Sign() int {
+	return d.bigInt().Sign()
+}
+
+// IsZero reports whether d equals 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and d2 and returns -1 if d < d2, 0 if d == d2 and +1 if d > d2
+func (d Decimal) Cmp(d2 Decimal) int {
+	a, b := align(d, d2)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and d2 are numerically equal ("1.50" equals "1.5")
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.bigInt()), scale: d.scale}
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.bigInt()), scale: d.scale}
+}
+
+// Add returns d + d2
+func (d Decimal) Add(d2 Decimal) Decimal {
+	a, b := align(d, d2)
+	return Decimal{value: a.Add(a, b), scale: maxScale(d, d2)}
+}
+
+// Sub returns d - d2
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	a, b := align(d, d2)
+	return Decimal{value: a.Sub(a, b), scale: maxScale(d, d2)}
+}
+
+// Mul returns d * d2
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.bigInt(), d2.bigInt()), scale: d.scale + d2.scale}
+}
+
+// Div returns d / d2 rounded half away from zero to the given number of decimal places, panics if d2 is zero or
+// places is negative
+func (d Decimal) Div(d2 Decimal, places int32) Decimal {
+	if d2.IsZero() {
+		panic("bitstamp: decimal division by zero")
+	}
+	if places < 0 {
+		panic("bitstamp: negative decimal places")
+	}
+
+	// d / d2 = (v1 * 10^s2) / (v2 * 10^s1), calculate with one extra digit and round
+	num := new(big.Int).Mul(d.bigInt(), pow10(d2.scale+places+1))
+	den := new(big.Int).Mul(d2.bigInt(), pow10(d.scale))
+	q := num.Quo(num, den)
+
+	return Decimal{value: q, scale: places + 1}.Round(places)
+}
+
+// Round rounds half away from zero to the given number of decimal places (Example: 1.005 -> 1.01), panics if
+// places is negative
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		panic("bitstamp: negative decimal places")
+	}
+	if places >= d.scale {
+		return d.rescale(places)
+	}
+
+	q, r := new(big.Int).QuoRem(d.bigInt(), pow10(d.scale-places), new(big.Int))
+	half := new(big.Int).Mul(r.Abs(r), big.NewInt(2))
+	if half.Cmp(pow10(d.scale-places)) >= 0 {
+		if d.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return Decimal{value: q, scale: places}
+}
+
+// Truncate drops digits after the given number of decimal places (Example: 1.009 -> 1.00), panics if places is
+// negative
+func (d Decimal) Truncate(places int32) Decimal {
+	if places < 0 {
+		panic("bitstamp: negative decimal places")
+	}
+	if places >= d.scale {
+		return d.rescale(places)
+	}
+
+	return Decimal{value: new(big.Int).Quo(d.bigInt(), pow10(d.scale-places)), scale: places}
+}
+
+// MarshalJSON decimals are encoded as strings to avoid any loss of precision
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes decimals from both JSON strings and numbers, null and empty strings are decoded as zero
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	b = bytes.Trim(b, `"`)
+	if len(b) == 0 || string(b) == "null" {
+		*d = Decimal{}
+		return nil
+	}
+
+	v, err := NewDecimal(string(b))
+	if err != nil {
+		return err
+	}
+	*d = v
+
+	return nil
+}
+
+// RoundAmount rounds an amount quoted in base currency to the decimals supported by the pair
+func (i GetTradingPairInfoResult) RoundAmount(d Decimal) Decimal {
+	return d.Round(int32(i.BaseDecimals))
+}
+
+// RoundPrice rounds a price quoted in counter currency to the decimals supported by the pair
+func (i GetTradingPairInfoResult) RoundPrice(d Decimal) Decimal {
+	return d.Round(int32(i.CounterDecimals))
+}
+
+func (d Decimal) bigInt() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+
+	return d.value
+}
+
+func (d Decimal) rescale(scale int32) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.bigInt(), pow10(scale-d.scale)), scale: scale}
+}
+
+// align returns values of both decimals at the same scale
+func align(d, d2 Decimal) (*big.Int, *big.Int) {
+	scale := maxScale(d, d2)
+	return d.rescale(scale).value, d2.rescale(scale).value
+}
+
+func maxScale(d, d2 Decimal) int32 {
+	if d.scale > d2.scale {
+		return d.scale
+	}
+
+	return d2.scale
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,177 @@
+package bitstamp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/georlav/bitstamp"
+)
+
+func TestNewDecimal(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{input: "52261.99", expected: "52261.99"},
+		{input: "0.25000000", expected: "0.25000000"},
+		{input: "-0.5", expected: "-0.5"},
+		{input: "-.5", expected: "-0.5"},
+		{input: "+12", expected: "12"},
+		{input: "1e-8", expected: "0.00000001"},
+		{input: "1.5E3", expected: "1500"},
+		{input: "0", expected: "0"},
+		{input: "", err: bitstamp.ErrInvalidDecimal},
+		{input: "abc", err: bitstamp.ErrInvalidDecimal},
+		{input: "0x10", err: bitstamp.ErrInvalidDecimal},
+		{input: "1_000", err: bitstamp.ErrInvalidDecimal},
+		{input: "--1", err: bitstamp.ErrInvalidDecimal},
+		{input: "1e1000", expected: "1" + strings.Repeat("0", 1000)},
+		{input: "1e2000000000", err: bitstamp.ErrInvalidDecimal},
+		{input: "1e-1001", err: bitstamp.ErrInvalidDecimal},
+		{input: "0." + strings.Repeat("1", 1001), err: bitstamp.ErrInvalidDecimal},
+		{input: "0.1e-1000", err: bitstamp.ErrInvalidDecimal},
+	}
+
+	for _, tc := range testCases {
+		d, err := bitstamp.NewDecimal(tc.input)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("Expected error %v for `%s` got %v", tc.err, tc.input, err)
+		}
+
+		if err == nil && d.String() != tc.expected {
+			t.Fatalf("Expected `%s` to be %s got %s", tc.input, tc.expected, d)
+		}
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := bitstamp.MustDecimal("0.1")
+	b := bitstamp.MustDecimal("0.2")
+
+	if v := a.Add(b); v.String() != "0.3" {
+		t.Fatalf("Expected 0.1 + 0.2 to be 0.3 got %s", v)
+	}
+
+	if v := a.Sub(b); v.String() != "-0.1" {
+		t.Fatalf("Expected 0.1 - 0.2 to be -0.1 got %s", v)
+	}
+
+	if v := bitstamp.MustDecimal("52261.99").Mul(bitstamp.MustDecimal("0.015")); v.String() != "783.92985" {
+		t.Fatalf("Expected 52261.99 * 0.015 to be 783.92985 got %s", v)
+	}
+
+	if v := bitstamp.MustDecimal("10").Div(bitstamp.MustDecimal("3"), 4); v.String() != "3.3333" {
+		t.Fatalf("Expected 10 / 3 to be 3.3333 got %s", v)
+	}
+
+	if v := bitstamp.MustDecimal("-2").Div(bitstamp.MustDecimal("3"), 2); v.String() != "-0.67" {
+		t.Fatalf("Expected -2 / 3 to be -0.67 got %s", v)
+	}
+
+	if !bitstamp.MustDecimal("1.50").Equal(bitstamp.MustDecimal("1.5")) {
+		t.Fatal("Expected 1.50 to equal 1.5")
+	}
+
+	if bitstamp.MustDecimal("1.49").Cmp(bitstamp.MustDecimal("1.5")) != -1 {
+		t.Fatal("Expected 1.49 to be less than 1.5")
+	}
+
+	var zero bitstamp.Decimal
+	if !zero.IsZero() || zero.String() != "0" || !zero.Add(a).Equal(a) {
+		t.Fatal("Expected zero value to be usable as 0")
+	}
+}
+
+func TestDecimal_Panics(t *testing.T) {
+	d := bitstamp.MustDecimal("123.456")
+
+	testCases := []struct {
+		name string
+		op   func()
+	}{
+		{name: "div by zero", op: func() { d.Div(bitstamp.Decimal{}, 2) }},
+		{name: "div negative places", op: func() { d.Div(bitstamp.MustDecimal("3"), -1) }},
+		{name: "round negative places", op: func() { d.Round(-1) }},
+		{name: "truncate negative places", op: func() { d.Truncate(-1) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("Expected a panic")
+				}
+			}()
+
+			tc.op()
+		})
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	testCases := []struct {
+		input     string
+		places    int32
+		rounded   string
+		truncated string
+	}{
+		{input: "1.005", places: 2, rounded: "1.01", truncated: "1.00"},
+		{input: "1.004", places: 2, rounded: "1.00", truncated: "1.00"},
+		{input: "-1.005", places: 2, rounded: "-1.01", truncated: "-1.00"},
+		{input: "0.123456789", places: 8, rounded: "0.12345679", truncated: "0.12345678"},
+		{input: "2.5", places: 0, rounded: "3", truncated: "2"},
+		{input: "1.2", places: 3, rounded: "1.200", truncated: "1.200"},
+	}
+
+	for _, tc := range testCases {
+		d := bitstamp.MustDecimal(tc.input)
+		if v := d.Round(tc.places); v.String() != tc.rounded {
+			t.Fatalf("Expected %s rounded to %d places to be %s got %s", tc.input, tc.places, tc.rounded, v)
+		}
+
+		if v := d.Truncate(tc.places); v.String() != tc.truncated {
+			t.Fatalf("Expected %s truncated to %d places to be %s got %s", tc.input, tc.places, tc.truncated, v)
+		}
+	}
+
+	info := bitstamp.GetTradingPairInfoResult{BaseDecimals: 8, CounterDecimals: 2}
+	if v := info.RoundPrice(bitstamp.MustDecimal("52261.995")); v.String() != "52262.00" {
+		t.Fatalf("Expected price to be rounded to 52262.00 got %s", v)
+	}
+	if v := info.RoundAmount(bitstamp.MustDecimal("0.123456789")); v.String() != "0.12345679" {
+		t.Fatalf("Expected amount to be rounded to 0.12345679 got %s", v)
+	}
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	var v struct {
+		String bitstamp.Decimal `json:"string"`
+		Number bitstamp.Decimal `json:"number"`
+		Null   bitstamp.Decimal `json:"null"`
+		Empty  bitstamp.Decimal `json:"empty"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"string": "0.25000000", "number": 0.0000001, "null": null, "empty": ""}`), &v); err != nil {
+		t.Fatalf("Failed to decode decimals, %s", err)
+	}
+
+	if v.String.String() != "0.25000000" || v.Number.String() != "0.0000001" || !v.Null.IsZero() || !v.Empty.IsZero() {
+		t.Fatalf("Unexpected decoded decimals %+v", v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to encode decimals, %s", err)
+	}
+
+	if string(b) != `{"string":"0.25000000","number":"0.0000001","null":"0","empty":"0"}` {
+		t.Fatalf("Unexpected encoded decimals %s", b)
+	}
+
+	if err := json.Unmarshal([]byte(`{"string": "abc"}`), &v); !errors.Is(err, bitstamp.ErrInvalidDecimal) {
+		t.Fatalf("Expected invalid decimal error got %v", err)
+	}
+}
@@ -84,6 +84,13 @@ func ExampleWebsocketAPI() {
 		case bitstamp.LiveOrderBookChannel:
 			fmt.Println("Message: ", v.Channel, v.Event, v.Data)
 
+		// private channels, require bitstamp.PrivateChannelsOption
+		case bitstamp.LiveMyOrdersChannel:
+			fmt.Println("Message: ", v.Channel, v.Event, v.Data, v.Data.ClientOrderID)
+
+		case bitstamp.LiveMyTradesChannel:
+			fmt.Println("Message: ", v.Channel, v.Event, v.Data, v.Data.Side)
+
 		case bitstamp.LiveDetailOrderBookChannel:
 			fmt.Println("Message: ", v.Channel, v.Event, v.Data)
 
@@ -93,6 +100,10 @@ func ExampleWebsocketAPI() {
 		case bitstamp.WebSocketMessage:
 			fmt.Println("Event Message: ", v.Channel, v.Event, v.Data)
 
+		// emitted only when bitstamp.AutoReconnectOption is used
+		case bitstamp.WebsocketReconnect:
+			fmt.Println("Reconnected: ", v.Attempts, v.Reason)
+
 		default:
 			fmt.Println("Unknown message: ", msg.RawMessage)
 		}
@@ -112,3 +123,67 @@ func ExampleWebsocketAPI() {
 	// Get active subscriptions
 	fmt.Println("Active subscriptions", ws.GetSubscriptions())
 }
+
+func ExampleOrderBook() {
+	ws, err := bitstamp.NewWebsocketAPI()
+	if err != nil {
+		log.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	// local order book is built from an http snapshot and kept up to date using the diff order book channel
+	book := bitstamp.NewOrderBook(bitstamp.NewHTTPAPI(), bitstamp.BTCUSD)
+
+	msgCH, err := ws.Consume(ctx, book.Channel())
+	if err != nil {
+		log.Fatalf("failed to subscribe to channels, %s", err)
+	}
+
+	go func() {
+		if err := book.Run(ctx, msgCH); err != nil {
+			log.Println("order book stopped,", err)
+		}
+	}()
+
+	for range book.Updates() {
+		bid, _ := book.BestBid()
+		ask, _ := book.BestAsk()
+		spread, _ := book.Spread()
+
+		fmt.Println("Best bid", bid.Price, bid.Amount, "Best ask", ask.Price, ask.Amount, "Spread", spread)
+	}
+}
+
+func ExampleWebsocketAPI_Serve() {
+	ws, err := bitstamp.NewWebsocketAPI()
+	if err != nil {
+		log.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	// handlers registered for specific pairs are subscribed automatically by Serve
+	ws.OnTrade(func(m bitstamp.LiveTickerChannel) {
+		fmt.Println("Trade: ", m.Channel, m.Data.Price, m.Data.Amount)
+	}, bitstamp.BTCUSD, bitstamp.ETHUSD)
+
+	ws.OnOrderBook(func(m bitstamp.LiveOrderBookChannel) {
+		fmt.Println("Order book: ", m.Channel, m.Data.Bids[0], m.Data.Asks[0])
+	}, bitstamp.BTCUSD)
+
+	// errors and events are delivered to raw handlers
+	ws.OnRaw(func(m bitstamp.WebsocketMessage) {
+		if m.Error != nil {
+			log.Println(m.Error)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	if err := ws.Serve(ctx); err != nil {
+		log.Println("stopped serving,", err)
+	}
+}
@@ -0,0 +1,231 @@
+package bitstamp
+
+import (
+	"context"
+	"strings"
+)
+
+// messageHandler a registered handler, prefix is the channel prefix of the messages it handles
+type messageHandler struct {
+	prefix string
+	// pairs to filter messages by, empty means all pairs
+	pairs map[Pair]struct{}
+	fn    func(msg interface{})
+}
+
+// OnTrade registers a handler for live_trades_[currency_pair] messages, if no pairs are given messages of all
+// pairs are handled
+func (w *WebsocketAPI) OnTrade(fn func(LiveTickerChannel), pairs ...Pair) {
+	w.addHandler("live_trades_", pairs, func(msg interface{}) {
+		if v, ok := msg.(LiveTickerChannel); ok {
+			fn(v)
+		}
+	})
+}
+
+// OnOrder registers a handler for live_orders_[currency_pair] messages, if no pairs are given messages of all
+// pairs are handled
+func (w *WebsocketAPI) OnOrder(fn func(LiveOrdersChannel), pairs ...Pair) {
+	w.addHandler("live_orders_", pairs, func(msg interface{}) {
+		if v, ok := msg.(LiveOrdersChannel); ok {
+			fn(v)
+		}
+	})
+}
+
+// OnOrderBook registers a handler for order_book_[currency_pair] messages, if no pairs are given messages of all
+// pairs are handled
+func (w *WebsocketAPI) OnOrderBook(fn func(LiveOrderBookChannel), pairs ...Pair) {
+	w.addHandler("order_book_", pairs, func(msg interface{}) {
+		if v, ok := msg.(LiveOrderBookChannel); ok {
+			fn(v)
+		}
+	})
+}
+
+// OnDetailOrderBook registers a handler for detail_order_book_[currency_pair] messages, if no pairs are given
+// messages of all pairs are handled
+func (w *WebsocketAPI) OnDetailOrderBook(fn func(LiveDetailOrderBookChannel), pairs ...Pair) {
+	w.addHandler("detail_order_book_", pairs, func(msg interface{}) {
+		if v, ok := msg.(LiveDetailOrderBookChannel); ok {
+			fn(v)
+		}
+	})
+}
+
+// OnDiffOrderBook registers a handler for diff_order_book_[currency_pair] messages, if no pairs are given
+// messages of all pairs are handled
+func (w *WebsocketAPI) OnDiffOrderBook(fn func(LiveFullOrderBook), pairs ...Pair) {
+	w.addHandler("diff_order_book_", pairs, func(msg interface{}) {
+		if v, ok := msg.(LiveFullOrderBook); ok {
+			fn(v)
+		}
+	})
+}
+
+// OnMyOrder registers a handler for private-my_orders_[currency_pair] messages, if no pairs are given messages of
+// all pairs are handled
+func (w *WebsocketAPI) OnMyOrder(fn func(LiveMyOrdersChannel), pairs ...Pair) {
+	w.addHandler("private-my_orders_", pairs, func(msg interface{}) {
+		if v, ok := msg.(LiveMyOrdersChannel); ok {
+			fn(v)
+		}
+	})
+}
+
+// OnMyTrade registers a handler for private-my_trades_[currency_pair] messages, if no pairs are given messages of
+// all pairs are handled
+func (w *WebsocketAPI) OnMyTrade(fn func(LiveMyTradesChannel), pairs ...Pair) {
+	w.addHandler("private-my_trades_", pairs, func(msg interface{}) {
+		if v, ok := msg.(LiveMyTradesChannel); ok {
+			fn(v)
+		}
+	})
+}
+
+// OnRaw registers a handler that receives every message including events and errors
+func (w *WebsocketAPI) OnRaw(fn func(WebsocketMessage)) {
+	w.handlersMu.Lock()
+	defer w.handlersMu.Unlock()
+
+	w.rawHandlers = append(w.rawHandlers, fn)
+}
+
+// Dispatch calls the handlers that match a message, handlers are called sequentially in registration order.
+// Use it when consuming messages manually, Serve dispatches all messages automatically.
+func (w *WebsocketAPI) Dispatch(m WebsocketMessage) {
+	// handlers are only appended so the slices can be used without holding the lock
+	w.handlersMu.RLock()
+	raw := w.rawHandlers
+	handlers := w.handlers
+	w.handlersMu.RUnlock()
+
+	for i := range raw {
+		raw[i](m)
+	}
+
+	name := messageChannel(m.Message)
+	if name == "" {
+		return
+	}
+
+	for i := range handlers {
+		if !strings.HasPrefix(name, handlers[i].prefix) {
+			continue
+		}
+
+		if len(handlers[i].pairs) > 0 {
+			p, ok := channelPair(handlers[i].prefix, name)
+			if _, found := handlers[i].pairs[p]; !ok || !found {
+				continue
+			}
+		}
+
+		handlers[i].fn(m.Message)
+	}
+}
+
+// Serve subscribes to the channels of handlers that are registered for specific pairs plus the given channels,
+// consumes messages and dispatches them to handlers until ctx is done or the stream is closed. It returns the
+// error that closed the stream.
+func (w *WebsocketAPI) Serve(ctx context.Context, channels ...Channel) error {
+	messages, err := w.Consume(ctx, append(w.handlerChannels(), channels...)...)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for m := range messages {
+		w.Dispatch(m)
+
+		if m.Error != nil {
+			lastErr = m.Error
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return lastErr
+}
+
+func (w *WebsocketAPI) addHandler(prefix string, pairs []Pair, fn func(msg interface{})) {
+	h := messageHandler{prefix: prefix, fn: fn}
+	if len(pairs) > 0 {
+		h.pairs = make(map[Pair]struct{}, len(pairs))
+		for i := range pairs {
+			h.pairs[pairs[i]] = struct{}{}
+		}
+	}
+
+	w.handlersMu.Lock()
+	defer w.handlersMu.Unlock()
+
+	w.handlers = append(w.handlers, h)
+}
+
+// handlerChannels returns the channels of handlers that are registered for specific pairs
+func (w *WebsocketAPI) handlerChannels() []Channel {
+	w.handlersMu.RLock()
+	defer w.handlersMu.RUnlock()
+
+	all := getChannels()
+	names := make(map[string]Channel, len(all))
+	for c, name := range all {
+		names[name] = c
+	}
+
+	var (
+		result []Channel
+		seen   = make(map[Channel]struct{})
+	)
+
+	for i := range w.handlers {
+		for p := range w.handlers[i].pairs {
+			c, ok := names[w.handlers[i].prefix+p.String()]
+			if _, dup := seen[c]; !ok || dup {
+				continue
+			}
+			seen[c] = struct{}{}
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// messageChannel returns the channel name of a parsed message
+func messageChannel(msg interface{}) string {
+	switch v := msg.(type) {
+	case LiveTickerChannel:
+		return v.Channel
+	case LiveOrdersChannel:
+		return v.Channel
+	case LiveOrderBookChannel:
+		return v.Channel
+	case LiveDetailOrderBookChannel:
+		return v.Channel
+	case LiveFullOrderBook:
+		return v.Channel
+	case LiveMyOrdersChannel:
+		return v.Channel
+	case LiveMyTradesChannel:
+		return v.Channel
+	case WebSocketMessage:
+		return v.Channel
+	}
+
+	return ""
+}
+
+// channelPair extracts the pair of a channel name, private channel names have a user id suffix
+// (Example: private-my_orders_btcusd-1234)
+func channelPair(prefix string, name string) (Pair, bool) {
+	name = strings.TrimPrefix(name, prefix)
+	if i := strings.IndexByte(name, '-'); i != -1 {
+		name = name[:i]
+	}
+
+	return getPairByName(name)
+}
@@ -63,11 +63,15 @@ func GetGBPPairs() []Pair {
 	return result
 }
 
+// GetAllChannels get all public channels, private channels can be retrieved using GetMyOrdersChannels and
+// GetMyTradesChannels
 func GetAllChannels() []Channel {
 	var result []Channel
 
 	for key := range getChannels() {
-		result = append(result, key)
+		if !key.IsPrivate() {
+			result = append(result, key)
+		}
 	}
 
 	return result
@@ -77,7 +81,7 @@ func GetEuroChannels() []Channel {
 	var result []Channel
 
 	for key := range getChannels() {
-		if strings.HasSuffix(key.String(), "eur") {
+		if !key.IsPrivate() && strings.HasSuffix(key.String(), "eur") {
 			result = append(result, key)
 		}
 	}
@@ -89,7 +93,7 @@ func GetUSDChannels() []Channel {
 	var result []Channel
 
 	for key := range getChannels() {
-		if strings.HasSuffix(key.String(), "usd") {
+		if !key.IsPrivate() && strings.HasSuffix(key.String(), "usd") {
 			result = append(result, key)
 		}
 	}
@@ -101,7 +105,7 @@ func GetBTCChannels() []Channel {
 	var result []Channel
 
 	for key := range getChannels() {
-		if strings.HasSuffix(key.String(), "btc") {
+		if !key.IsPrivate() && strings.HasSuffix(key.String(), "btc") {
 			result = append(result, key)
 		}
 	}
@@ -113,7 +117,7 @@ func GetGBPChannels() []Channel {
 	var result []Channel
 
 	for key := range getChannels() {
-		if strings.HasSuffix(key.String(), "gbp") {
+		if !key.IsPrivate() && strings.HasSuffix(key.String(), "gbp") {
 			result = append(result, key)
 		}
 	}
@@ -171,6 +175,44 @@ func GetDiffOrderBookChannels() []Channel {
 	return getChannelsByPrefix("diff_order_book_")
 }
 
+// GetMyOrdersChannel get private my orders channel for a pair (private-my_orders_[currency_pair])
+func GetMyOrdersChannel(p Pair) Channel {
+	return getChannel("private-my_orders_", p)
+}
+
+// GetMyOrdersChannels get all private my orders channels (private-my_orders_[*])
+func GetMyOrdersChannels() []Channel {
+	return getChannelsByPrefix("private-my_orders_")
+}
+
+// GetMyTradesChannel get private my trades channel for a pair (private-my_trades_[currency_pair])
+func GetMyTradesChannel(p Pair) Channel {
+	return getChannel("private-my_trades_", p)
+}
+
+// GetMyTradesChannels get all private my trades channels (private-my_trades_[*])
+func GetMyTradesChannels() []Channel {
+	return getChannelsByPrefix("private-my_trades_")
+}
+
+// IsPrivate reports whether channel requires a websockets token (see PrivateChannelsOption)
+func (c Channel) IsPrivate() bool {
+	return strings.HasPrefix(c.String(), privateChannelPrefix)
+}
+
+// getPairByName finds a pair using its name, name can be in url symbol (btcusd) or display (BTC/USD) format
+func getPairByName(name string) (Pair, bool) {
+	name = strings.ToLower(strings.ReplaceAll(name, "/", ""))
+
+	for k, v := range getPairs() {
+		if v == name {
+			return k, true
+		}
+	}
+
+	return NILNIL, false
+}
+
 func getChannel(prefix string, suffix Pair) Channel {
 	chans := getChannels()
 	ch := fmt.Sprintf("%s%s", prefix, suffix)
@@ -178,3 +178,37 @@ func TestGetDiffOrderBookChannels(t *testing.T) {
 		}
 	}
 }
+
+func TestPair_BaseQuote(t *testing.T) {
+	testCases := []struct {
+		pair  bitstamp.Pair
+		base  bitstamp.Currency
+		quote bitstamp.Currency
+	}{
+		{pair: bitstamp.BTCEUR, base: "btc", quote: "eur"},
+		{pair: bitstamp.BTCUSDT, base: "btc", quote: "usdt"},
+		{pair: bitstamp.USDCUSDT, base: "usdc", quote: "usdt"},
+		{pair: bitstamp.ETH2ETH, base: "eth2", quote: "eth"},
+		{pair: bitstamp.XRPPAX, base: "xrp", quote: "pax"},
+		{pair: bitstamp.NILNIL, base: "", quote: ""},
+	}
+
+	for _, tc := range testCases {
+		if tc.pair.Base() != tc.base || tc.pair.Quote() != tc.quote {
+			t.Fatalf("Expected %s to split to %s/%s got %s/%s", tc.pair, tc.base, tc.quote, tc.pair.Base(), tc.pair.Quote())
+		}
+	}
+}
+
+func TestGetAllCurrencies(t *testing.T) {
+	currencies := bitstamp.GetAllCurrencies()
+	if len(currencies) == 0 {
+		t.Fatal("Failed to retrieve currencies")
+	}
+
+	for _, c := range currencies {
+		if c == "" {
+			t.Fatal("Expected currencies to not contain empty values")
+		}
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,18 +29,23 @@ type HTTPAPI struct {
 	secret  string
 	debug   bool
 	handle  *http.Client
+	// limiter is applied to all requests, classLimiters add separate budgets per endpoint class
+	limiter       *RateLimiter
+	classLimiters map[EndpointClass]*RateLimiter
 }
 
 // NewHTTPAPI create a new client instance
 //
 // REQUEST LIMITS
 // Do not do more than 8000 requests per 10 minutes or your IP address will be banned.
-// For real time data use websocket API.
+// For real time data use websocket API. Requests of all clients are limited by a shared rate limiter by default,
+// see RateLimiterOption.
 func NewHTTPAPI(options ...option) *HTTPAPI {
 	api := HTTPAPI{
 		baseURL: "https://www.bitstamp.net",
 		handle:  http.DefaultClient,
 		debug:   false,
+		limiter: defaultRateLimiter,
 	}
 
 	// override defaults via available functional options
@@ -89,10 +96,21 @@ func (h *HTTPAPI) GetTickerHourly(ctx context.Context, p Pair) (*GetTickerRespon
 	return &result, nil
 }
 
-// GetOrderBook retrieve information for all supported trading pairs
+// GetOrderBook retrieves the order book of a pair grouped by price
 // Docs https://www.bitstamp.net/api/#order-book
 func (h *HTTPAPI) GetOrderBook(ctx context.Context, p Pair) (*GetOrderBookResponse, error) {
-	resp, err := h.doRequest(ctx, http.MethodGet, fmt.Sprintf(orderBookURL, p), nil, false)
+	return h.GetOrderBookWithOptions(ctx, p, GetOrderBookRequest{})
+}
+
+// GetOrderBookWithOptions retrieves the order book of a pair using request options like grouping
+// Docs https://www.bitstamp.net/api/#order-book
+func (h *HTTPAPI) GetOrderBookWithOptions(ctx context.Context, p Pair, r GetOrderBookRequest) (*GetOrderBookResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodGet, fmt.Sprintf(orderBookURL+"?"+params.Encode(), p), nil, false)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +127,7 @@ func (h *HTTPAPI) GetOrderBook(ctx context.Context, p Pair) (*GetOrderBookRespon
 // Docs https://www.bitstamp.net/api/#transactions
 func (h *HTTPAPI) GetTransactions(ctx context.Context, p Pair, r GetTransactionsRequest) ([]GetTransactionResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -146,7 +164,7 @@ func (h *HTTPAPI) GetTradingPairsInfo(ctx context.Context) ([]GetTradingPairInfo
 // Docs https://www.bitstamp.net/api/#ohlc_data
 func (h *HTTPAPI) GetOHLCData(ctx context.Context, p Pair, r GetOHLCDataRequest) (*GetOHLCDataResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -204,7 +222,7 @@ func (h *HTTPAPI) GetAccountBalance(ctx context.Context, p *Pair) (*GetAccountBa
 // Docs https://www.bitstamp.net/api/#user-transactions
 func (h *HTTPAPI) GetUserTransactions(ctx context.Context, p *Pair, r GetUserTransactionsRequest) ([]GetUserTransactionResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -231,7 +249,7 @@ func (h *HTTPAPI) GetUserTransactions(ctx context.Context, p *Pair, r GetUserTra
 // Docs https://www.bitstamp.net/api/#crypto-transactions
 func (h *HTTPAPI) GetCryptoTransactions(ctx context.Context, r GetCryptoTransactionsRequest) (*GetCryptoTransactionsResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -248,6 +266,51 @@ func (h *HTTPAPI) GetCryptoTransactions(ctx context.Context, r GetCryptoTransact
 	return &result, nil
 }
 
+// GetCryptoDeposits retrieves deposits of the given currency, it uses GetCryptoTransactions so it is limited
+// to the transactions of the requested page
+// Docs https://www.bitstamp.net/api/#crypto-transactions
+func (h *HTTPAPI) GetCryptoDeposits(ctx context.Context, c Currency, r GetCryptoTransactionsRequest) ([]CryptoTransaction, error) {
+	transactions, err := h.GetCryptoTransactions(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []CryptoTransaction
+	for i := range transactions.Deposits {
+		if strings.EqualFold(transactions.Deposits[i].Currency, c.String()) {
+			result = append(result, transactions.Deposits[i])
+		}
+	}
+
+	return result, nil
+}
+
+// GetCryptoDepositAddress retrieves the deposit address of the given crypto currency (btc, bch, xrp, xlm etc)
+// Docs https://www.bitstamp.net/api/#crypto-deposits
+func (h *HTTPAPI) GetCryptoDepositAddress(ctx context.Context, c Currency) (*GetCryptoDepositAddressResponse, error) {
+	resp, err := h.doRequest(ctx, http.MethodPost, fmt.Sprintf(cryptoDepositAddressURL, c), nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result GetCryptoDepositAddressResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.Address == "" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
 // GetOpenOrders retrieves open orders, after call data is cached for 10 seconds
 // Docs https://www.bitstamp.net/api/#open-orders
 func (h *HTTPAPI) GetOpenOrders(ctx context.Context) ([]GetOpenOrderResponse, error) {
@@ -268,7 +331,7 @@ func (h *HTTPAPI) GetOpenOrders(ctx context.Context) ([]GetOpenOrderResponse, er
 // Docs https://www.bitstamp.net/api/#order-status
 func (h *HTTPAPI) GetOrderStatus(ctx context.Context, r GetOrderStatusRequest) (*GetOrderStatusResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -299,7 +362,7 @@ func (h *HTTPAPI) GetOrderStatus(ctx context.Context, r GetOrderStatusRequest) (
 // Docs https://www.bitstamp.net/api/#cancel-order
 func (h *HTTPAPI) CancelOrder(ctx context.Context, r CancelOrderRequest) (*CancelOrderResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -351,7 +414,7 @@ func (h *HTTPAPI) CancelAllOrders(ctx context.Context, p *Pair) (*CancelAllOrder
 // Docs https://www.bitstamp.net/api/#buy-order
 func (h *HTTPAPI) CreateBuyLimitOrder(ctx context.Context, p Pair, r CreateBuyLimitOrderRequest) (*CreateOrderResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -378,11 +441,42 @@ func (h *HTTPAPI) CreateBuyLimitOrder(ctx context.Context, p Pair, r CreateBuyLi
 	return &result, nil
 }
 
+// CreateBuyMarketOrder creates a buy market order
+// Docs https://www.bitstamp.net/api/#buy-market-order
+func (h *HTTPAPI) CreateBuyMarketOrder(ctx context.Context, p Pair, r CreateBuyMarketOrderRequest) (*CreateOrderResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, fmt.Sprintf(buyMarketOrderURL, p), bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result CreateOrderResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.ID == "" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
 // CreateBuyInstantOrder creates a new buy instant order
 // Docs https://www.bitstamp.net/api/#buy-instant-order
 func (h *HTTPAPI) CreateBuyInstantOrder(ctx context.Context, p Pair, r CreateBuyInstantOrderRequest) (*CreateOrderResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -413,7 +507,7 @@ func (h *HTTPAPI) CreateBuyInstantOrder(ctx context.Context, p Pair, r CreateBuy
 // Docs https://www.bitstamp.net/api/#sell-order
 func (h *HTTPAPI) CreateSellLimitOrder(ctx context.Context, p Pair, r CreateSellLimitOrderRequest) (*CreateOrderResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -440,11 +534,42 @@ func (h *HTTPAPI) CreateSellLimitOrder(ctx context.Context, p Pair, r CreateSell
 	return &result, nil
 }
 
+// CreateSellMarketOrder creates a sell market order
+// Docs https://www.bitstamp.net/api/#sell-market-order
+func (h *HTTPAPI) CreateSellMarketOrder(ctx context.Context, p Pair, r CreateSellMarketOrderRequest) (*CreateOrderResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, fmt.Sprintf(sellMarketOrderURL, p), bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result CreateOrderResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.ID == "" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
 // CreateSellInstantOrder creates a sell instant order
 // Docs https://www.bitstamp.net/api/#sell-instant-order
 func (h *HTTPAPI) CreateSellInstantOrder(ctx context.Context, p Pair, r CreateSellInstantOrderRequest) (*CreateOrderResponse, error) {
 	params := url.Values{}
-	if err := schema.NewEncoder().Encode(r, params); err != nil {
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
 		return nil, err
 	}
 
@@ -471,6 +596,340 @@ func (h *HTTPAPI) CreateSellInstantOrder(ctx context.Context, p Pair, r CreateSe
 	return &result, nil
 }
 
+// GetWithdrawalRequests retrieves withdrawal requests
+// Docs https://www.bitstamp.net/api/#withdrawal-requests
+func (h *HTTPAPI) GetWithdrawalRequests(ctx context.Context, r GetWithdrawalRequestsRequest) ([]GetWithdrawalRequestResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, withdrawalRequestsURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result []GetWithdrawalRequestResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		// handle status 200 with error
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return result, nil
+}
+
+// CreateCryptoWithdrawal creates a withdrawal of the given crypto currency (btc, bch, xrp, xlm etc)
+// Docs https://www.bitstamp.net/api/#crypto-withdrawals
+func (h *HTTPAPI) CreateCryptoWithdrawal(ctx context.Context, c Currency, r CreateCryptoWithdrawalRequest) (*CreateCryptoWithdrawalResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, fmt.Sprintf(cryptoWithdrawalURL, c), bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result CreateCryptoWithdrawalResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.ID == 0 {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
+// TransferToMain transfers balance from a sub account to the main account
+// Docs https://www.bitstamp.net/api/#transfer-balance-from-sub-to-main-account
+func (h *HTTPAPI) TransferToMain(ctx context.Context, r TransferToMainRequest) (*TransferResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, transferToMainURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result TransferResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.Status != "ok" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
+// TransferFromMain transfers balance from the main account to a sub account
+// Docs https://www.bitstamp.net/api/#transfer-balance-from-main-to-sub-account
+func (h *HTTPAPI) TransferFromMain(ctx context.Context, r TransferFromMainRequest) (*TransferResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, transferFromMainURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result TransferResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.Status != "ok" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
+// OpenBankWithdrawal opens a bank (fiat) withdrawal request (SEPA or international)
+// Docs https://www.bitstamp.net/api/#open-bank-withdrawal
+func (h *HTTPAPI) OpenBankWithdrawal(ctx context.Context, r OpenBankWithdrawalRequest) (*OpenBankWithdrawalResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, openBankWithdrawalURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result OpenBankWithdrawalResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.WithdrawalID == 0 {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
+// GetBankWithdrawalStatus retrieves the status of a bank (fiat) withdrawal request
+// Docs https://www.bitstamp.net/api/#bank-withdrawal-status
+func (h *HTTPAPI) GetBankWithdrawalStatus(ctx context.Context, r GetBankWithdrawalStatusRequest) (*GetBankWithdrawalStatusResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, bankWithdrawalStatusURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	// handle status 200 with error, error reason is not always a string so decoding might fail as well. Errors
+	// might not have a status key which would otherwise be decoded as open status.
+	var result GetBankWithdrawalStatusResponse
+	err = json.NewDecoder(teeReader).Decode(&result)
+
+	var fields map[string]json.RawMessage
+	if err == nil {
+		err = json.Unmarshal(buf.Bytes(), &fields)
+	}
+	_, hasStatus := fields["status"]
+	_, hasCode := fields["code"]
+
+	if err != nil || !hasStatus || hasCode || result.Status == WithdrawalRequestStatusUnknown {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
+// CancelBankWithdrawal cancels a bank (fiat) withdrawal request, only open withdrawal requests can be canceled
+// Docs https://www.bitstamp.net/api/#cancel-bank-withdrawal
+func (h *HTTPAPI) CancelBankWithdrawal(ctx context.Context, r CancelBankWithdrawalRequest) (*CancelBankWithdrawalResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, cancelBankWithdrawalURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result CancelBankWithdrawalResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.ID == 0 {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
+// CreateLiquidationAddress creates a new bitcoin liquidation address, bitcoins deposited to it are automatically
+// sold and converted to the requested liquidation currency
+// Docs https://www.bitstamp.net/api/#new-liquidation-address
+func (h *HTTPAPI) CreateLiquidationAddress(ctx context.Context, r CreateLiquidationAddressRequest) (*CreateLiquidationAddressResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, newLiquidationAddressURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result CreateLiquidationAddressResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.Address == "" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result, nil
+}
+
+// GetLiquidationAddressInfo retrieves deposits and conversions of liquidation addresses
+// Docs https://www.bitstamp.net/api/#liquidation-address-info
+func (h *HTTPAPI) GetLiquidationAddressInfo(ctx context.Context, r GetLiquidationAddressInfoRequest) ([]GetLiquidationAddressInfoResponse, error) {
+	params := url.Values{}
+	if err := newSchemaEncoder().Encode(r, params); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.doRequest(ctx, http.MethodPost, liquidationAddressInfoURL, bytes.NewBuffer([]byte(params.Encode())), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result []GetLiquidationAddressInfoResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		// handle status 200 with error
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return result, nil
+}
+
+// GetTradingFee retrieves maker and taker trading fees of a pair
+// Docs https://www.bitstamp.net/api/#trading-fees
+func (h *HTTPAPI) GetTradingFee(ctx context.Context, p Pair) (*Fee, error) {
+	resp, err := h.doRequest(ctx, http.MethodPost, fmt.Sprintf(tradingFeeURL, p), nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var result GetTradingFeeResponse
+	if err := json.NewDecoder(teeReader).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// handle status 200 with error
+	if result.CurrencyPair == "" {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	return &result.Fees, nil
+}
+
+// GetTradingFees retrieves maker and taker trading fees of all pairs, pairs that are not
+// supported by the client are omitted
+// Docs https://www.bitstamp.net/api/#trading-fees
+func (h *HTTPAPI) GetTradingFees(ctx context.Context) (map[Pair]Fee, error) {
+	resp, err := h.doRequest(ctx, http.MethodPost, tradingFeesURL, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	teeReader := io.TeeReader(resp.Body, &buf)
+
+	var fees []GetTradingFeeResponse
+	if err := json.NewDecoder(teeReader).Decode(&fees); err != nil {
+		// handle status 200 with error
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf.Bytes()))
+		resp.StatusCode = http.StatusTeapot
+		return nil, newErrorFromResponse(resp)
+	}
+
+	result := make(map[Pair]Fee, len(fees))
+	for i := range fees {
+		if p, ok := getPairByName(fees[i].CurrencyPair); ok {
+			result[p] = fees[i].Fees
+		}
+	}
+
+	return result, nil
+}
+
 // GetWebsocketsToken retrieves a token that can be used for subscribing to private WebSocket channels.
 // Docs https://www.bitstamp.net/api/#websockets-token
 // For private Websocket access, you need to contact support.
@@ -488,6 +947,16 @@ func (h *HTTPAPI) GetWebsocketsToken(ctx context.Context) (*GetWebsocketTokenRes
 	return &result, nil
 }
 
+// newSchemaEncoder creates an encoder that can be used to map request structs to url values
+func newSchemaEncoder() *schema.Encoder {
+	enc := schema.NewEncoder()
+	enc.RegisterEncoder(Decimal{}, func(v reflect.Value) string {
+		return v.Interface().(Decimal).String()
+	})
+
+	return enc
+}
+
 // newRequest creates a http request that can be used to call public APIs
 func (h *HTTPAPI) newRequest(ctx context.Context, method string, uri string, body io.Reader) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s%s", h.baseURL, uri), body)
@@ -550,6 +1019,10 @@ func (h *HTTPAPI) newAuthenticatedRequest(ctx context.Context, method string, ur
 }
 
 func (h *HTTPAPI) doRequest(ctx context.Context, method string, uri string, payload io.Reader, private bool) (*http.Response, error) {
+	if err := h.wait(ctx, private); err != nil {
+		return nil, err
+	}
+
 	var (
 		req    *http.Request
 		reqErr error
@@ -594,3 +1067,17 @@ func (h *HTTPAPI) doRequest(ctx context.Context, method string, uri string, payl
 
 	return resp, nil
 }
+
+// wait blocks until the rate limiters allow a request
+func (h *HTTPAPI) wait(ctx context.Context, private bool) error {
+	class := PublicEndpoints
+	if private {
+		class = PrivateEndpoints
+	}
+
+	if err := waitLimiters(ctx, h.classLimiters[class], h.limiter); err != nil {
+		return fmt.Errorf("failed to wait for %s rate limit, %w", class, err)
+	}
+
+	return nil
+}
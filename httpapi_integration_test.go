@@ -38,7 +38,7 @@ func TestHTTPClient_GetTicker_Integration(t *testing.T) {
 				}
 			}
 
-			if err == nil && result.High == "" {
+			if err == nil && result.High.IsZero() {
 				t.Fatal("Expected to have a value for high got none")
 			}
 
@@ -78,7 +78,7 @@ func TestHTTPClient_GetTickerHourly_Integration(t *testing.T) {
 				}
 			}
 
-			if err == nil && result.High == "" {
+			if err == nil && result.High.IsZero() {
 				t.Fatal("Expected to have a value for high got none")
 			}
 
@@ -289,10 +289,10 @@ func TestHTTPClient_GetEURUSDConversionRate_Integration(t *testing.T) {
 				}
 			}
 
-			if result.Buy == "0.0" || result.Buy == "" {
+			if result.Buy.IsZero() {
 				t.Fatal("Expected to have a buy rate got none")
 			}
-			if result.Sell == "0.0" || result.Sell == "" {
+			if result.Sell.IsZero() {
 				t.Fatal("Expected to have a buy rate got none")
 			}
 
@@ -667,11 +667,11 @@ func TestHTTPClient_CreateBuyLimitOrder_Integration(t *testing.T) {
 		// 		pair: bitstamp.ZRXEUR,
 		// 		request: bitstamp.CreateBuyLimitOrderRequest{
 		// 			// buy 250 zrx
-		// 			Amount: "250",
+		// 			Amount: bitstamp.MustDecimal("250"),
 		// 			// At 0.86 euro
-		// 			Price: "0.86",
+		// 			Price: bitstamp.MustDecimal("0.86"),
 		// 			// Sell if price reaches 2.011 euro
-		// 			LimitPrice: "2.011",
+		// 			LimitPrice: bitstamp.MustDecimal("2.011"),
 		// 		},
 		// 	},
 		// 	expectedCode: http.StatusOK,
@@ -681,9 +681,9 @@ func TestHTTPClient_CreateBuyLimitOrder_Integration(t *testing.T) {
 			input: input{
 				pair: bitstamp.ZRXEUR,
 				request: bitstamp.CreateBuyLimitOrderRequest{
-					Amount:     "-10",
-					Price:      "-100.00",
-					LimitPrice: "0.3999",
+					Amount:     bitstamp.MustDecimal("-10"),
+					Price:      bitstamp.MustDecimal("-100.00"),
+					LimitPrice: bitstamp.MustDecimal("0.3999"),
 				},
 			},
 			expectedCode: http.StatusTeapot,
@@ -693,7 +693,7 @@ func TestHTTPClient_CreateBuyLimitOrder_Integration(t *testing.T) {
 			input: input{
 				pair: bitstamp.NILNIL,
 				request: bitstamp.CreateBuyLimitOrderRequest{
-					Amount: "0.0",
+					Amount: bitstamp.MustDecimal("0.0"),
 				},
 			},
 			expectedCode: http.StatusNotFound,
@@ -738,7 +738,7 @@ func TestHTTPClient_CreateBuyInstantOrder_Integration(t *testing.T) {
 		// 	input: input{
 		// 		pair: bitstamp.BTCEUR,
 		// 		request: bitstamp.CreateBuyInstantOrderRequest{
-		// 			Amount: "20.01",
+		// 			Amount: bitstamp.MustDecimal("20.01"),
 		// 		},
 		// 	},
 		// 	expectedCode: http.StatusOK,
@@ -748,7 +748,7 @@ func TestHTTPClient_CreateBuyInstantOrder_Integration(t *testing.T) {
 			input: input{
 				pair: bitstamp.BTCEUR,
 				request: bitstamp.CreateBuyInstantOrderRequest{
-					Amount: "10.01",
+					Amount: bitstamp.MustDecimal("10.01"),
 				},
 			},
 			expectedCode: http.StatusOK,
@@ -794,7 +794,7 @@ func TestHTTPClient_CreateSellInstantOrder_Integration(t *testing.T) {
 				pair: bitstamp.BTCEUR,
 				request: bitstamp.CreateSellInstantOrderRequest{
 					// sell 10 btc
-					Amount: "10",
+					Amount: bitstamp.MustDecimal("10"),
 				},
 			},
 			expectedCode: http.StatusOK,
@@ -804,7 +804,7 @@ func TestHTTPClient_CreateSellInstantOrder_Integration(t *testing.T) {
 			input: input{
 				pair: bitstamp.BTCEUR,
 				request: bitstamp.CreateSellInstantOrderRequest{
-					Amount: "-10.66",
+					Amount: bitstamp.MustDecimal("-10.66"),
 				},
 			},
 			expectedCode: http.StatusOK,
@@ -850,11 +850,11 @@ func TestHTTPClient_CreateSellLimitOrder_Integration(t *testing.T) {
 		// 		pair: bitstamp.ZRXEUR,
 		// 		request: bitstamp.CreateSellLimitOrderRequest{
 		// 			// Sell 20 ZRXEUR
-		// 			Amount: "10",
+		// 			Amount: bitstamp.MustDecimal("10"),
 		// 			// At 100 euro
-		// 			Price: "100.00",
+		// 			Price: bitstamp.MustDecimal("100.00"),
 		// 			// Buy again if price falls to 0.39 euro
-		// 			LimitPrice: "0.3999",
+		// 			LimitPrice: bitstamp.MustDecimal("0.3999"),
 		// 		},
 		// 	},
 		// 	expectedCode: http.StatusOK,
@@ -864,9 +864,9 @@ func TestHTTPClient_CreateSellLimitOrder_Integration(t *testing.T) {
 			input: input{
 				pair: bitstamp.ZRXEUR,
 				request: bitstamp.CreateSellLimitOrderRequest{
-					Amount:     "-10",
-					Price:      "-100.00",
-					LimitPrice: "0.3999",
+					Amount:     bitstamp.MustDecimal("-10"),
+					Price:      bitstamp.MustDecimal("-100.00"),
+					LimitPrice: bitstamp.MustDecimal("0.3999"),
 				},
 			},
 			expectedCode: http.StatusTeapot,
@@ -876,7 +876,7 @@ func TestHTTPClient_CreateSellLimitOrder_Integration(t *testing.T) {
 			input: input{
 				pair: bitstamp.NILNIL,
 				request: bitstamp.CreateSellLimitOrderRequest{
-					Amount: "0.0",
+					Amount: bitstamp.MustDecimal("0.0"),
 				},
 			},
 			expectedCode: http.StatusNotFound,
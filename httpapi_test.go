@@ -1,10 +1,20 @@
 package bitstamp_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/georlav/bitstamp"
 	"github.com/georlav/httprawmock"
@@ -55,7 +65,7 @@ func TestHTTPClient_GetTicker(t *testing.T) {
 				}
 			}
 
-			if err == nil && result.High == "" {
+			if err == nil && result.High.IsZero() {
 				t.Fatal("Expected to have a value for high got none")
 			}
 
@@ -110,7 +120,7 @@ func TestHTTPClient_GetTickerHourly(t *testing.T) {
 				}
 			}
 
-			if err == nil && result.High == "" {
+			if err == nil && result.High.IsZero() {
 				t.Fatal("Expected to have a value for high got none")
 			}
 
@@ -171,8 +181,19 @@ func TestHTTPClient_GetAccountBalance(t *testing.T) {
 				}
 			}
 
-			if err == nil && result.BtcAvailable != "156.00000000" {
-				t.Fatalf("Expected to have %s bitcoins got %s", "156.00000000", result.BtcAvailable)
+			if err == nil && result.Balances["btc"].Available.String() != "156.00000000" {
+				t.Fatalf("Expected to have %s bitcoins got %s", "156.00000000", result.Balances["btc"].Available)
+			}
+
+			if err == nil {
+				legacy, err := result.Legacy()
+				if err != nil {
+					t.Fatalf("Failed to map legacy balances, %s", err)
+				}
+
+				if legacy.BtcAvailable != "156.00000000" {
+					t.Fatalf("Expected to have %s bitcoins got %s", "156.00000000", legacy.BtcAvailable)
+				}
 			}
 
 			_ = result
@@ -181,6 +202,41 @@ func TestHTTPClient_GetAccountBalance(t *testing.T) {
 	}
 }
 
+func TestGetAccountBalancesResponse_UnmarshalJSON(t *testing.T) {
+	var result bitstamp.GetAccountBalancesResponse
+	if err := json.Unmarshal([]byte(`{"btc_available": "1.5", "btc_balance": "2.0", "btc_reserved": "0.5", "btc_withdrawal_fee": "0.00050000", "btceur_fee": "0.220", "newcoin_available": "10.00", "newcoineur_fee": "0.300", "fee": 0.2200, "status": "ok", "limits": {"btc": "1"}, "eth_available": "n/a"}`), &result); err != nil {
+		t.Fatalf("Failed to decode balances, %s", err)
+	}
+
+	btc, ok := result.Balance(bitstamp.BTCEUR.Base())
+	if !ok || btc.Available.String() != "1.5" || btc.Balance.String() != "2.0" || btc.Reserved.String() != "0.5" || btc.WithdrawalFee.String() != "0.00050000" {
+		t.Fatalf("Unexpected btc balance %+v", btc)
+	}
+
+	if fee, ok := result.PairFee(bitstamp.BTCEUR); !ok || fee.String() != "0.220" {
+		t.Fatalf("Expected BTC/EUR fee to be 0.220 got %s", fee)
+	}
+
+	// currencies unknown to the client are mapped as well
+	if result.Balances["newcoin"].Available.String() != "10.00" {
+		t.Fatalf("Expected to map unknown currencies got %+v", result)
+	}
+
+	// fees are keyed by pair like GetTradingFees, unknown pairs are omitted
+	if len(result.Fees) != 1 || result.Fees[bitstamp.BTCEUR].String() != "0.220" {
+		t.Fatalf("Expected only BTC/EUR fee got %+v", result.Fees)
+	}
+
+	// fields that are not decimals are ignored
+	if _, ok := result.Balance("eth"); ok {
+		t.Fatalf("Expected eth balance with invalid value to be ignored got %+v", result.Balances["eth"])
+	}
+
+	if result.Fee.String() != "0.2200" {
+		t.Fatalf("Expected fee to be 0.2200 got %s", result.Fee)
+	}
+}
+
 func TestHTTPClient_GetUserTransactions(t *testing.T) {
 	type input struct {
 		pair    *bitstamp.Pair
@@ -264,3 +320,1126 @@ func TestHTTPClient_GetUserTransactions(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPClient_CreateBuyMarketOrder(t *testing.T) {
+	type input struct {
+		pair    bitstamp.Pair
+		request bitstamp.CreateBuyMarketOrderRequest
+	}
+
+	testCases := []struct {
+		description  string
+		input        input
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description: "Should create a buy market order",
+			input: input{
+				pair:    bitstamp.BTCUSD,
+				request: bitstamp.CreateBuyMarketOrderRequest{Amount: bitstamp.MustDecimal("0.01")},
+			},
+			responseFile: "testdata/create_buy_market_order_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description: "Should fail to create a buy market order due to insufficient balance",
+			input: input{
+				pair:    bitstamp.BTCUSD,
+				request: bitstamp.CreateBuyMarketOrderRequest{Amount: bitstamp.MustDecimal("1000")},
+			},
+			responseFile: "testdata/create_market_order_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+		{
+			description: "Should fail to create a buy market order due to invalid pair",
+			input: input{
+				pair:    bitstamp.NILNIL,
+				request: bitstamp.CreateBuyMarketOrderRequest{Amount: bitstamp.MustDecimal("0.01")},
+			},
+			responseFile: "testdata/not_found_page_404.txt",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/buy/market/{pair}/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.CreateBuyMarketOrder(context.Background(), tc.input.pair, tc.input.request)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.ID == "" {
+				t.Fatal("Expected to have an order id got none")
+			}
+
+			_ = result
+			// t.Logf("%+v", result)
+		})
+	}
+}
+
+func TestHTTPClient_CreateSellMarketOrder(t *testing.T) {
+	type input struct {
+		pair    bitstamp.Pair
+		request bitstamp.CreateSellMarketOrderRequest
+	}
+
+	testCases := []struct {
+		description  string
+		input        input
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description: "Should create a sell market order",
+			input: input{
+				pair:    bitstamp.BTCUSD,
+				request: bitstamp.CreateSellMarketOrderRequest{Amount: bitstamp.MustDecimal("0.01")},
+			},
+			responseFile: "testdata/create_sell_market_order_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description: "Should fail to create a sell market order due to insufficient balance",
+			input: input{
+				pair:    bitstamp.BTCUSD,
+				request: bitstamp.CreateSellMarketOrderRequest{Amount: bitstamp.MustDecimal("1000")},
+			},
+			responseFile: "testdata/create_market_order_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+		{
+			description: "Should fail to create a sell market order due to invalid pair",
+			input: input{
+				pair:    bitstamp.NILNIL,
+				request: bitstamp.CreateSellMarketOrderRequest{Amount: bitstamp.MustDecimal("0.01")},
+			},
+			responseFile: "testdata/not_found_page_404.txt",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/sell/market/{pair}/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.CreateSellMarketOrder(context.Background(), tc.input.pair, tc.input.request)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.ID == "" {
+				t.Fatal("Expected to have an order id got none")
+			}
+
+			_ = result
+			// t.Logf("%+v", result)
+		})
+	}
+}
+
+func TestHTTPClient_CreateBuyLimitOrder_EncodesDecimals(t *testing.T) {
+	b, err := os.ReadFile("testdata/create_buy_market_order_200.txt")
+	if err != nil {
+		t.Fatalf("failed to parse response file, %s", err)
+	}
+
+	var form url.Values
+	ts := httprawmock.NewUnstartedServer(
+		httprawmock.NewRoute(http.MethodPost, "/api/v2/buy/{pair}/", b),
+	)
+	next := ts.Config.Handler
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse request form, %s", err)
+		}
+		form = r.PostForm
+
+		next.ServeHTTP(w, r)
+	})
+	ts.Start()
+	defer t.Cleanup(ts.Close)
+
+	c := bitstamp.NewHTTPAPI(
+		bitstamp.BaseURLOption(ts.URL),
+	)
+
+	_, err = c.CreateBuyLimitOrder(context.Background(), bitstamp.BTCUSD, bitstamp.CreateBuyLimitOrderRequest{
+		Amount: bitstamp.MustDecimal("0.00100000"),
+		Price:  bitstamp.MustDecimal("52261.99"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to retrieve data, %s", err)
+	}
+
+	if form.Get("amount") != "0.00100000" || form.Get("price") != "52261.99" {
+		t.Fatalf("Expected amount and price to be encoded as decimals got %s", form.Encode())
+	}
+
+	// zero decimals are omitted
+	if _, ok := form["limit_price"]; ok {
+		t.Fatalf("Expected limit price to be omitted got %s", form.Get("limit_price"))
+	}
+}
+
+func TestHTTPClient_GetWithdrawalRequests(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         bitstamp.GetWithdrawalRequestsRequest
+		responseFile  string
+		expectedCode  int
+		expectedCount int
+	}{
+		{
+			description:   "Should fetch withdrawal requests",
+			input:         bitstamp.GetWithdrawalRequestsRequest{TimeDelta: 86400 * 7},
+			responseFile:  "testdata/get_withdrawal_requests_200.txt",
+			expectedCode:  http.StatusOK,
+			expectedCount: 2,
+		},
+		{
+			description:  "Should fail to fetch withdrawal requests due to validation error",
+			input:        bitstamp.GetWithdrawalRequestsRequest{TimeDelta: 500000000},
+			responseFile: "testdata/get_withdrawal_requests_200_with_validation_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/withdrawal-requests/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.GetWithdrawalRequests(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if len(result) != tc.expectedCount {
+				t.Fatalf("Expected to have %d withdrawal requests got %d", tc.expectedCount, len(result))
+			}
+
+			if err == nil && result[0].Status != bitstamp.WithdrawalRequestStatusFinished {
+				t.Fatalf("Expected status to be %s got %s", bitstamp.WithdrawalRequestStatusFinished, result[0].Status)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_CreateCryptoWithdrawal(t *testing.T) {
+	type input struct {
+		currency bitstamp.Currency
+		request  bitstamp.CreateCryptoWithdrawalRequest
+	}
+
+	testCases := []struct {
+		description  string
+		input        input
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description: "Should create a bitcoin withdrawal",
+			input: input{
+				currency: bitstamp.BTCEUR.Base(),
+				request: bitstamp.CreateCryptoWithdrawalRequest{
+					Amount:  bitstamp.MustDecimal("0.5"),
+					Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+				},
+			},
+			responseFile: "testdata/create_crypto_withdrawal_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description: "Should fail to create a ripple withdrawal due to missing destination tag",
+			input: input{
+				currency: bitstamp.XRPEUR.Base(),
+				request: bitstamp.CreateCryptoWithdrawalRequest{
+					Amount:  bitstamp.MustDecimal("100"),
+					Address: "rDsbeomae4FXwgQTJp9Rs64Qg9vDiTCdBv",
+				},
+			},
+			responseFile: "testdata/create_crypto_withdrawal_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/{currency}_withdrawal/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.CreateCryptoWithdrawal(context.Background(), tc.input.currency, tc.input.request)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.ID == 0 {
+				t.Fatal("Expected to have a withdrawal id got none")
+			}
+		})
+	}
+}
+
+func TestGetUserTransactionResponse_UnmarshalJSON(t *testing.T) {
+	var result []bitstamp.GetUserTransactionResponse
+	if err := json.Unmarshal([]byte(`[
+		{"fee": "0.00", "btc_usd": "0.00", "datetime": "2021-11-21 21:21:21.000000", "usd": 0, "btc": 0, "type": "0", "id": 1, "eur": "250000.00"},
+		{"fee": "0.52", "order_id": 1427863548616704, "id": 2, "ada_eur": 1.52, "btc_usd": "0.00", "datetime": "2021-11-21 21:21:21.000000", "type": "2", "ada": "171.05263157", "eur": "-260.00", "client_order_id": "123", "status": "Finished", "market": "ADA/EUR", "trade_details": {"maker": true}, "aaa_zzz": "2.5", "eth_btc": "0.00"}
+	]`), &result); err != nil {
+		t.Fatalf("Failed to decode user transactions, %s", err)
+	}
+
+	if result[0].Type != bitstamp.UserTransactionTypeDeposit || result[0].Amounts["eur"].String() != "250000.00" {
+		t.Fatalf("Expected a deposit of 250000.00 eur got %s of %s", result[0].Type, result[0].Amounts["eur"])
+	}
+
+	if _, ok := result[0].Pair(); ok {
+		t.Fatal("Expected deposit to have no pair")
+	}
+
+	trade := result[1]
+	if trade.Type != bitstamp.UserTransactionTypeMarketTrade || trade.OrderID != 1427863548616704 {
+		t.Fatalf("Expected a market trade of order 1427863548616704 got %s of %d", trade.Type, trade.OrderID)
+	}
+
+	if trade.Amounts["ada"].String() != "171.05263157" || trade.Rates["adaeur"].String() != "1.52" {
+		t.Fatalf("Expected ada amount and ada/eur rate got %+v", trade)
+	}
+
+	if len(trade.Amounts) != 2 || len(trade.Rates) != 4 {
+		t.Fatalf("Expected unexpected fields to be ignored got amounts %v and rates %v", trade.Amounts, trade.Rates)
+	}
+
+	// rates of unknown pairs and zero rates are skipped
+	for i := 0; i < 10; i++ {
+		if p, ok := trade.Pair(); !ok || p != bitstamp.ADAEUR {
+			t.Fatalf("Expected pair to be %s got %s", bitstamp.ADAEUR, p)
+		}
+	}
+
+	// values of supported currencies and pairs must be decimals
+	for _, input := range []string{`[{"id": 3, "btc": "abc"}]`, `[{"id": 3, "btc_usd": "abc"}]`} {
+		if err := json.Unmarshal([]byte(input), &result); !errors.Is(err, bitstamp.ErrInvalidDecimal) {
+			t.Fatalf("Expected error %s for %s got %v", bitstamp.ErrInvalidDecimal, input, err)
+		}
+	}
+}
+
+func TestHTTPClient_GetCryptoTransactions(t *testing.T) {
+	b, err := os.ReadFile("testdata/get_crypto_transactions_200.txt")
+	if err != nil {
+		t.Fatalf("failed to parse response file, %s", err)
+	}
+
+	ts := httprawmock.NewServer(
+		httprawmock.NewRoute(http.MethodPost, "/api/v2/crypto-transactions/", b),
+	)
+	defer t.Cleanup(ts.Close)
+
+	c := bitstamp.NewHTTPAPI(
+		bitstamp.BaseURLOption(ts.URL),
+	)
+
+	result, err := c.GetCryptoTransactions(context.Background(), bitstamp.GetCryptoTransactionsRequest{Limit: 100})
+	if err != nil {
+		t.Fatalf("Failed to retrieve data, %s", err)
+	}
+
+	if len(result.Deposits) != 2 || len(result.Withdrawals) != 1 {
+		t.Fatalf("Expected 2 deposits and 1 withdrawal got %d and %d", len(result.Deposits), len(result.Withdrawals))
+	}
+
+	if result.Deposits[0].Amount.String() != "0.25000000" {
+		t.Fatalf("Expected deposit amount to be 0.25000000 got %s", result.Deposits[0].Amount)
+	}
+
+	if !result.Deposits[0].Datetime.Time.Equal(time.Date(2021, 11, 19, 16, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected deposit time to be 2021-11-19 16:00:00 got %s", result.Deposits[0].Datetime.Time)
+	}
+
+	deposits, err := c.GetCryptoDeposits(context.Background(), bitstamp.XRPEUR.Base(), bitstamp.GetCryptoTransactionsRequest{Limit: 100})
+	if err != nil {
+		t.Fatalf("Failed to retrieve data, %s", err)
+	}
+
+	if len(deposits) != 1 || deposits[0].Currency != "XRP" {
+		t.Fatalf("Expected to have a single XRP deposit got %+v", deposits)
+	}
+}
+
+func TestHTTPClient_GetCryptoDepositAddress(t *testing.T) {
+	testCases := []struct {
+		description    string
+		input          bitstamp.Currency
+		responseFile   string
+		expectedCode   int
+		expectedTag    int64
+		expectedResult bool
+	}{
+		{
+			description:    "Should fetch bitcoin deposit address",
+			input:          bitstamp.BTCEUR.Base(),
+			responseFile:   "testdata/get_crypto_deposit_address_btc_200.txt",
+			expectedCode:   http.StatusOK,
+			expectedResult: true,
+		},
+		{
+			description:    "Should fetch ripple deposit address with destination tag",
+			input:          bitstamp.XRPEUR.Base(),
+			responseFile:   "testdata/get_crypto_deposit_address_xrp_200.txt",
+			expectedCode:   http.StatusOK,
+			expectedTag:    89250597,
+			expectedResult: true,
+		},
+		{
+			description:  "Should fail to fetch deposit address due to missing permission",
+			input:        bitstamp.XLMEUR.Base(),
+			responseFile: "testdata/get_crypto_deposit_address_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/{currency}_address/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.GetCryptoDepositAddress(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if (result != nil) != tc.expectedResult {
+				t.Fatalf("Expected to have a result %t got %+v", tc.expectedResult, result)
+			}
+
+			if result != nil && result.DestinationTag != tc.expectedTag {
+				t.Fatalf("Expected destination tag to be %d got %d", tc.expectedTag, result.DestinationTag)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_TransferToMain(t *testing.T) {
+	testCases := []struct {
+		description  string
+		input        bitstamp.TransferToMainRequest
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description: "Should transfer balance from sub account to main account",
+			input: bitstamp.TransferToMainRequest{
+				Amount:     bitstamp.MustDecimal("0.5"),
+				Currency:   bitstamp.BTCEUR.Base(),
+				SubAccount: "1234567",
+			},
+			responseFile: "testdata/transfer_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description: "Should fail to transfer balance due to insufficient balance",
+			input: bitstamp.TransferToMainRequest{
+				Amount:     bitstamp.MustDecimal("1000"),
+				Currency:   bitstamp.BTCEUR.Base(),
+				SubAccount: "1234567",
+			},
+			responseFile: "testdata/transfer_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := newSignedRequestServer(t, "key", "secret",
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/transfer-to-main/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+				bitstamp.APIKeyOption("key"),
+				bitstamp.APISecretOption("secret"),
+			)
+
+			result, err := c.TransferToMain(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.Status != "ok" {
+				t.Fatalf("Expected status to be ok got %s", result.Status)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_TransferFromMain(t *testing.T) {
+	testCases := []struct {
+		description  string
+		input        bitstamp.TransferFromMainRequest
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description: "Should transfer balance from main account to sub account",
+			input: bitstamp.TransferFromMainRequest{
+				Amount:     bitstamp.MustDecimal("100"),
+				Currency:   bitstamp.BTCEUR.Quote(),
+				SubAccount: "1234567",
+			},
+			responseFile: "testdata/transfer_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description: "Should fail to transfer balance due to insufficient balance",
+			input: bitstamp.TransferFromMainRequest{
+				Amount:     bitstamp.MustDecimal("1000"),
+				Currency:   bitstamp.BTCEUR.Base(),
+				SubAccount: "1234567",
+			},
+			responseFile: "testdata/transfer_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := newSignedRequestServer(t, "key", "secret",
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/transfer-from-main/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+				bitstamp.APIKeyOption("key"),
+				bitstamp.APISecretOption("secret"),
+			)
+
+			result, err := c.TransferFromMain(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.Status != "ok" {
+				t.Fatalf("Expected status to be ok got %s", result.Status)
+			}
+		})
+	}
+}
+
+// newSignedRequestServer starts a mock server that fails the test if an incoming request is not properly signed
+func newSignedRequestServer(t *testing.T, key, secret string, routes ...httprawmock.Route) *httprawmock.Server {
+	t.Helper()
+
+	// environmental variables override functional options, make sure client signs using the expected credentials
+	t.Setenv("BITSTAMP_KEY", key)
+	t.Setenv("BITSTAMP_SECRET", secret)
+
+	ts := httprawmock.NewUnstartedServer(routes...)
+	next := ts.Config.Handler
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request body, %s", err)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		rq := r.URL.RawQuery
+		if rq != "" {
+			rq = "?" + rq
+		}
+		signatureString := fmt.Sprintf(
+			"BITSTAMP %s%s%s%s%s%s%s%s%s%s",
+			key, r.Method, r.Host, r.URL.Path, rq, r.Header.Get("Content-Type"), r.Header.Get("X-Auth-Nonce"), r.Header.Get("X-Auth-Timestamp"), "v2", string(body))
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(signatureString))
+		if expected := hex.EncodeToString(mac.Sum(nil)); r.Header.Get("X-Auth-Signature") != expected {
+			t.Errorf("Expected signature %s got %s", expected, r.Header.Get("X-Auth-Signature"))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+	ts.Start()
+
+	return ts
+}
+
+func TestHTTPClient_OpenBankWithdrawal(t *testing.T) {
+	testCases := []struct {
+		description  string
+		input        bitstamp.OpenBankWithdrawalRequest
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description: "Should open a SEPA bank withdrawal",
+			input: bitstamp.OpenBankWithdrawalRequest{
+				Amount:          bitstamp.MustDecimal("1500"),
+				AccountCurrency: bitstamp.BTCEUR.Quote(),
+				Name:            "John Doe",
+				IBAN:            "GR1601101250000000012300695",
+				BIC:             "ETHNGRAA",
+				Address:         "Panepistimiou 1",
+				PostalCode:      "10564",
+				City:            "Athens",
+				Country:         "GR",
+				Type:            bitstamp.BankWithdrawalTypeSEPA,
+			},
+			responseFile: "testdata/open_bank_withdrawal_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description: "Should fail to open an international bank withdrawal due to invalid IBAN",
+			input: bitstamp.OpenBankWithdrawalRequest{
+				Amount:          bitstamp.MustDecimal("1500"),
+				AccountCurrency: bitstamp.BTCUSD.Quote(),
+				Name:            "John Doe",
+				IBAN:            "GR00",
+				BIC:             "ETHNGRAA",
+				Address:         "Panepistimiou 1",
+				PostalCode:      "10564",
+				City:            "Athens",
+				Country:         "GR",
+				Type:            bitstamp.BankWithdrawalTypeInternational,
+				BankName:        "National Bank of Greece",
+				BankAddress:     "Aiolou 86",
+				BankPostalCode:  "10559",
+				BankCity:        "Athens",
+				BankCountry:     "GR",
+				Currency:        bitstamp.BTCEUR.Quote(),
+			},
+			responseFile: "testdata/open_bank_withdrawal_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/withdrawal/open/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.OpenBankWithdrawal(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.WithdrawalID == 0 {
+				t.Fatal("Expected to have a withdrawal id got none")
+			}
+		})
+	}
+}
+
+func TestHTTPClient_GetBankWithdrawalStatus(t *testing.T) {
+	testCases := []struct {
+		description    string
+		responseFile   string
+		expectedCode   int
+		expectedStatus bitstamp.WithdrawalRequestStatus
+	}{
+		{
+			description:    "Should fetch bank withdrawal status",
+			responseFile:   "testdata/get_bank_withdrawal_status_200.txt",
+			expectedCode:   http.StatusOK,
+			expectedStatus: bitstamp.WithdrawalRequestStatusInProcess,
+		},
+		{
+			description:  "Should fail to fetch bank withdrawal status due to unknown withdrawal",
+			responseFile: "testdata/get_bank_withdrawal_status_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+		{
+			description:  "Should fail to fetch bank withdrawal status due to error without status",
+			responseFile: "testdata/get_bank_withdrawal_status_200_with_error_without_status.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/withdrawal/status/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.GetBankWithdrawalStatus(context.Background(), bitstamp.GetBankWithdrawalStatusRequest{ID: 6033012})
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && tc.expectedCode != http.StatusOK {
+				t.Fatalf("Expected error with status code %d got %+v", tc.expectedCode, result)
+			}
+
+			if err == nil && result.Status != tc.expectedStatus {
+				t.Fatalf("Expected status to be %s got %s", tc.expectedStatus, result.Status)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_CancelBankWithdrawal(t *testing.T) {
+	testCases := []struct {
+		description  string
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description:  "Should cancel a bank withdrawal",
+			responseFile: "testdata/cancel_bank_withdrawal_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Should fail to cancel a bank withdrawal that is already processed",
+			responseFile: "testdata/cancel_bank_withdrawal_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/withdrawal/cancel/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.CancelBankWithdrawal(context.Background(), bitstamp.CancelBankWithdrawalRequest{ID: 6033012})
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.Type != bitstamp.BankWithdrawalTypeSEPA {
+				t.Fatalf("Expected type to be %s got %s", bitstamp.BankWithdrawalTypeSEPA, result.Type)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_CreateLiquidationAddress(t *testing.T) {
+	testCases := []struct {
+		description  string
+		input        bitstamp.CreateLiquidationAddressRequest
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description:  "Should create a liquidation address",
+			input:        bitstamp.CreateLiquidationAddressRequest{LiquidationCurrency: bitstamp.BTCEUR.Quote()},
+			responseFile: "testdata/create_liquidation_address_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Should fail to create a liquidation address due to invalid liquidation currency",
+			input:        bitstamp.CreateLiquidationAddressRequest{LiquidationCurrency: bitstamp.XRPEUR.Base()},
+			responseFile: "testdata/create_liquidation_address_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/liquidation_address/new/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.CreateLiquidationAddress(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.Address == "" {
+				t.Fatal("Expected to have an address got none")
+			}
+		})
+	}
+}
+
+func TestHTTPClient_GetLiquidationAddressInfo(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         bitstamp.GetLiquidationAddressInfoRequest
+		responseFile  string
+		expectedCode  int
+		expectedCount int
+	}{
+		{
+			description:   "Should fetch liquidation address info",
+			input:         bitstamp.GetLiquidationAddressInfoRequest{Address: "3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC"},
+			responseFile:  "testdata/get_liquidation_address_info_200.txt",
+			expectedCode:  http.StatusOK,
+			expectedCount: 1,
+		},
+		{
+			description:  "Should fail to fetch liquidation address info due to unknown address",
+			input:        bitstamp.GetLiquidationAddressInfoRequest{Address: "invalid"},
+			responseFile: "testdata/get_liquidation_address_info_200_with_error.txt",
+			expectedCode: http.StatusTeapot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/liquidation_address/info/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.GetLiquidationAddressInfo(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if len(result) != tc.expectedCount {
+				t.Fatalf("Expected to have %d liquidation addresses got %d", tc.expectedCount, len(result))
+			}
+
+			if err == nil && len(result[0].Transactions[0].Trades) != result[0].Transactions[0].Count {
+				t.Fatalf("Expected to have %d trades got %d", result[0].Transactions[0].Count, len(result[0].Transactions[0].Trades))
+			}
+		})
+	}
+}
+
+func TestHTTPClient_GetTradingFee(t *testing.T) {
+	testCases := []struct {
+		description  string
+		input        bitstamp.Pair
+		responseFile string
+		expectedCode int
+	}{
+		{
+			description:  "Should fetch trading fee for BTC/EUR",
+			input:        bitstamp.BTCEUR,
+			responseFile: "testdata/get_trading_fee_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Should fail to fetch trading fee due to invalid pair",
+			input:        bitstamp.NILNIL,
+			responseFile: "testdata/not_found_page_404.txt",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/fees/trading/{pair}/", b),
+			)
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			result, err := c.GetTradingFee(context.Background(), tc.input)
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+			}
+
+			if err == nil && result.Taker.String() != "0.40000" {
+				t.Fatalf("Expected taker fee to be 0.40000 got %s", result.Taker)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_GetTradingFees(t *testing.T) {
+	b, err := os.ReadFile("testdata/get_trading_fees_200.txt")
+	if err != nil {
+		t.Fatalf("failed to parse response file, %s", err)
+	}
+
+	ts := httprawmock.NewServer(
+		httprawmock.NewRoute(http.MethodPost, "/api/v2/fees/trading/", b),
+	)
+	defer t.Cleanup(ts.Close)
+
+	c := bitstamp.NewHTTPAPI(
+		bitstamp.BaseURLOption(ts.URL),
+	)
+
+	result, err := c.GetTradingFees(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to retrieve data, %s", err)
+	}
+
+	// unknown pairs are omitted
+	if len(result) != 3 {
+		t.Fatalf("Expected to have fees for 3 pairs got %d", len(result))
+	}
+
+	if fee, ok := result[bitstamp.XRPEUR]; !ok || fee.Maker.String() != "0.20000" {
+		t.Fatalf("Expected XRP/EUR maker fee to be 0.20000 got %+v", fee)
+	}
+}
+
+func TestHTTPClient_GetOrderBook(t *testing.T) {
+	testCases := []struct {
+		description     string
+		input           bitstamp.Pair
+		group           bitstamp.OrderBookGroup
+		responseFile    string
+		expectedGroup   string
+		expectedOrderID int64
+		expectedCode    int
+	}{
+		{
+			description:  "Should fetch order book for BTC/USD using default grouping",
+			input:        bitstamp.BTCUSD,
+			responseFile: "testdata/get_order_book_grouped_200.txt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:     "Should fetch ungrouped order book for BTC/USD",
+			input:           bitstamp.BTCUSD,
+			group:           bitstamp.OrderBookGroupNone,
+			responseFile:    "testdata/get_order_book_200.txt",
+			expectedCode:    http.StatusOK,
+			expectedGroup:   "0",
+			expectedOrderID: 1432101229445121,
+		},
+		{
+			description:   "Should fetch order book for BTC/USD grouped by price",
+			input:         bitstamp.BTCUSD,
+			group:         bitstamp.OrderBookGroupByPrice,
+			responseFile:  "testdata/get_order_book_grouped_200.txt",
+			expectedCode:  http.StatusOK,
+			expectedGroup: "1",
+		},
+		{
+			description:     "Should fetch order book for BTC/USD with orders by price",
+			input:           bitstamp.BTCUSD,
+			group:           bitstamp.OrderBookGroupOrdersByPrice,
+			responseFile:    "testdata/get_order_book_200.txt",
+			expectedCode:    http.StatusOK,
+			expectedGroup:   "2",
+			expectedOrderID: 1432101229445121,
+		},
+		{
+			description:  "Should fail to fetch order book due to invalid pair",
+			input:        bitstamp.NILNIL,
+			responseFile: "testdata/not_found_page_404.txt",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			ts := httprawmock.NewUnstartedServer(
+				httprawmock.NewRoute(http.MethodGet, "/api/v2/order_book/{pair}/", b),
+			)
+			var query url.Values
+			next := ts.Config.Handler
+			ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				next.ServeHTTP(w, r)
+			})
+			ts.Start()
+			defer t.Cleanup(ts.Close)
+
+			c := bitstamp.NewHTTPAPI(
+				bitstamp.BaseURLOption(ts.URL),
+			)
+
+			var result *bitstamp.GetOrderBookResponse
+			if tc.group == "" {
+				result, err = c.GetOrderBook(context.Background(), tc.input)
+			} else {
+				result, err = c.GetOrderBookWithOptions(context.Background(), tc.input, bitstamp.GetOrderBookRequest{
+					Group: tc.group,
+				})
+			}
+			if err != nil {
+				apiErr, ok := err.(bitstamp.Error)
+				if !ok || apiErr.StatusCode != tc.expectedCode {
+					t.Fatalf("Failed to retrieve data, %s", err)
+				}
+				return
+			}
+
+			if group := query.Get("group"); group != tc.expectedGroup {
+				t.Fatalf("Expected group parameter `%s` got `%s`", tc.expectedGroup, group)
+			}
+
+			if len(result.Bids) != 2 || len(result.Asks) != 2 {
+				t.Fatalf("Expected 2 bids and 2 asks got %d and %d", len(result.Bids), len(result.Asks))
+			}
+
+			bid := result.Bids[0]
+			if bid.Price.String() != "52261.99" || bid.Amount.String() != "0.10000000" || bid.OrderID != tc.expectedOrderID {
+				t.Fatalf("Unexpected best bid %+v", bid)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package bitstamp
 
-import "time"
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
 
 type option func(*HTTPAPI)
 type wsOption func(*WebsocketAPI)
@@ -40,9 +45,130 @@ func ClientTimeoutOption(seconds int64) func(api *HTTPAPI) {
 	}
 }
 
+// RateLimiterOption limit all requests using the sliding window limiter l, share the same limiter between clients
+// to apply a process wide limit. A nil limiter disables rate limiting. By default a limiter of DefaultRateLimit
+// requests per DefaultRatePeriod shared by all clients is used.
+func RateLimiterOption(l *RateLimiter) option {
+	return func(api *HTTPAPI) {
+		api.limiter = l
+	}
+}
+
+// EndpointRateLimiterOption add a separate budget for a class of endpoints using the sliding window limiter l, a
+// request is made only when it fits in the window of both the class limiter and the limiter set by
+// RateLimiterOption, it is then counted by both
+func EndpointRateLimiterOption(c EndpointClass, l *RateLimiter) option {
+	return func(api *HTTPAPI) {
+		if api.classLimiters == nil {
+			api.classLimiters = make(map[EndpointClass]*RateLimiter)
+		}
+		api.classLimiters[c] = l
+	}
+}
+
 // WSSetAddressOption changes the default websocket address
 func SetWSAddressOption(val string) wsOption {
 	return func(api *WebsocketAPI) {
 		api.address = val
 	}
 }
+
+// AutoReconnectOption enables automatic reconnection, when the connection drops or bitstamp requests a reconnect
+// the client redials, resubscribes to all tracked channels and emits a WebsocketReconnect message (disabled by default)
+func AutoReconnectOption() wsOption {
+	return func(api *WebsocketAPI) {
+		api.reconnect.enabled = true
+	}
+}
+
+// ReconnectBackoffOption change the minimum and maximum delay between reconnection attempts (defaults 1s and 1m)
+func ReconnectBackoffOption(min, max time.Duration) wsOption {
+	return func(api *WebsocketAPI) {
+		api.reconnect.minBackoff = min
+		api.reconnect.maxBackoff = max
+	}
+}
+
+// ReconnectMaxAttemptsOption limit reconnection attempts, zero means unlimited (default)
+func ReconnectMaxAttemptsOption(attempts int) wsOption {
+	return func(api *WebsocketAPI) {
+		api.reconnect.maxAttempts = attempts
+	}
+}
+
+// HeartbeatOption send a bts:heartbeat message and a ping frame every interval, use it together with
+// StaleTimeoutOption to detect half open connections (disabled by default)
+func HeartbeatOption(interval time.Duration) wsOption {
+	return func(api *WebsocketAPI) {
+		api.heartbeat = interval
+	}
+}
+
+// StaleTimeoutOption fail reads with ErrStaleConnection when no message or pong is received for the given
+// duration, timeout should be larger than heartbeat interval (disabled by default)
+func StaleTimeoutOption(timeout time.Duration) wsOption {
+	return func(api *WebsocketAPI) {
+		api.staleTimeout = timeout
+	}
+}
+
+// PrivateChannelsOption enables subscribing to private channels, api is used to retrieve websockets tokens and
+// must be configured with a key and a secret. Tokens are cached and refreshed before they expire.
+func PrivateChannelsOption(api *HTTPAPI) wsOption {
+	return func(w *WebsocketAPI) {
+		w.tokenAPI = api
+	}
+}
+
+// SubscriptionAckOption makes SubscribeToChannels and UnSubscribeFromChannels wait until the server confirms each
+// request (disabled by default)
+func SubscriptionAckOption() wsOption {
+	return func(api *WebsocketAPI) {
+		api.waitAck = true
+	}
+}
+
+// BufferOption buffer up to size parsed messages between the connection and the consumer of Consume and choose
+// what happens when the buffer is full, dropped and coalesced messages are counted (see DroppedMessages and
+// CoalescedMessages). Default is an unbuffered stream using OverflowBlock.
+func BufferOption(size int, policy OverflowPolicy) wsOption {
+	return func(api *WebsocketAPI) {
+		api.buffer = bufferConfig{size: size, policy: policy}
+	}
+}
+
+// DialerOption use a custom dialer, for example to connect through a proxy or with custom TLS roots. The dialer is
+// copied, websocket.DefaultDialer is used by default.
+func DialerOption(d *websocket.Dialer) wsOption {
+	return func(api *WebsocketAPI) {
+		api.dialer = d
+	}
+}
+
+// HeaderOption set headers of the websocket handshake request
+func HeaderOption(h http.Header) wsOption {
+	return func(api *WebsocketAPI) {
+		api.header = h
+	}
+}
+
+// CompressionOption negotiate per message compression (disabled by default)
+func CompressionOption() wsOption {
+	return func(api *WebsocketAPI) {
+		api.compression = true
+	}
+}
+
+// HandshakeTimeoutOption limit the duration of the websocket handshake, it overrides the timeout of the dialer
+func HandshakeTimeoutOption(timeout time.Duration) wsOption {
+	return func(api *WebsocketAPI) {
+		api.handshakeTimeout = timeout
+	}
+}
+
+// DeferConnectOption do not connect in NewWebsocketAPI, the connection is established by Connect or Consume
+func DeferConnectOption() wsOption {
+	return func(api *WebsocketAPI) {
+		api.deferConnect = true
+	}
+}
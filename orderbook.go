@@ -0,0 +1,453 @@
+package bitstamp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	ErrOrderBookGap          = errors.New("order book gap detected")
+	ErrOrderBookStreamClosed = errors.New("order book message stream closed")
+)
+
+// PriceLevel a price level of an order book, mapped from [price, amount]
+type PriceLevel struct {
+	Price  Decimal
+	Amount Decimal
+}
+
+// OrderLevel an order of an order book, mapped from [price, amount, order id]. Order id is optional and
+// is zero when the order book is grouped by price
+type OrderLevel struct {
+	Price   Decimal
+	Amount  Decimal
+	OrderID int64
+}
+
+// UnmarshalJSON decodes a [price, amount] array
+func (l *PriceLevel) UnmarshalJSON(b []byte) error {
+	fields, err := decodeLevel(b)
+	if err != nil {
+		return err
+	}
+
+	l.Price, l.Amount = fields.price, fields.amount
+
+	return nil
+}
+
+// MarshalJSON encodes level as a [price, amount] array
+func (l PriceLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]string{l.Price.String(), l.Amount.String()})
+}
+
+// UnmarshalJSON decodes a [price, amount, order id] array, order id is optional
+func (l *OrderLevel) UnmarshalJSON(b []byte) error {
+	fields, err := decodeLevel(b)
+	if err != nil {
+		return err
+	}
+
+	l.Price, l.Amount, l.OrderID = fields.price, fields.amount, fields.orderID
+
+	return nil
+}
+
+// MarshalJSON encodes level as a [price, amount, order id] array, order id is omitted if it is zero
+func (l OrderLevel) MarshalJSON() ([]byte, error) {
+	level := []string{l.Price.String(), l.Amount.String()}
+	if l.OrderID != 0 {
+		level = append(level, strconv.FormatInt(l.OrderID, 10))
+	}
+
+	return json.Marshal(level)
+}
+
+type levelFields struct {
+	price   Decimal
+	amount  Decimal
+	orderID int64
+}
+
+func decodeLevel(b []byte) (levelFields, error) {
+	var (
+		raw    []json.RawMessage
+		fields levelFields
+	)
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return fields, err
+	}
+
+	if len(raw) < 2 {
+		return fields, fmt.Errorf("expected order book level to have at least price and amount, got %s", b)
+	}
+
+	if err := json.Unmarshal(raw[0], &fields.price); err != nil {
+		return fields, fmt.Errorf("failed to decode order book level price, %w", err)
+	}
+
+	if err := json.Unmarshal(raw[1], &fields.amount); err != nil {
+		return fields, fmt.Errorf("failed to decode order book level amount, %w", err)
+	}
+
+	if len(raw) > 2 {
+		var id json.Number
+		if err := json.Unmarshal(raw[2], &id); err != nil {
+			return fields, fmt.Errorf("failed to decode order book level order id, %w", err)
+		}
+
+		orderID, err := id.Int64()
+		if err != nil {
+			return fields, fmt.Errorf("failed to decode order book level order id, %w", err)
+		}
+		fields.orderID = orderID
+	}
+
+	return fields, nil
+}
+
+// OrderBookUpdate notification sent every time the local order book changes
+type OrderBookUpdate struct {
+	// Microtimestamp of the last applied snapshot or diff
+	Microtimestamp Time
+	// Resynced is true when the book was rebuilt from a new snapshot
+	Resynced bool
+}
+
+// OrderBook a local order book of a pair, it is built from an HTTP snapshot and kept up to date using the
+// messages of the diff_order_book_[currency_pair] channel. All query methods are safe for concurrent use.
+type OrderBook struct {
+	api     *HTTPAPI
+	pair    Pair
+	channel string
+	updates chan OrderBookUpdate
+	// backoff between failed snapshot requests
+	backoff reconnectConfig
+
+	mu             sync.RWMutex
+	bids           []PriceLevel
+	asks           []PriceLevel
+	microtimestamp Time
+	synced         bool
+}
+
+type orderBookSnapshot struct {
+	book *GetOrderBookResponse
+	err  error
+}
+
+// NewOrderBook creates a local order book for a pair, snapshots are fetched using the given HTTP api
+func NewOrderBook(api *HTTPAPI, p Pair) *OrderBook {
+	return &OrderBook{
+		api:     api,
+		pair:    p,
+		channel: "diff_order_book_" + p.String(),
+		updates: make(chan OrderBookUpdate, 1),
+		backoff: reconnectConfig{
+			minBackoff: 250 * time.Millisecond,
+			maxBackoff: 30 * time.Second,
+		},
+	}
+}
+
+// Channel returns the websocket channel the order book must be fed with
+func (b *OrderBook) Channel() Channel {
+	return GetDiffOrderBookChannel(b.pair)
+}
+
+// Updates returns a channel that is notified every time the book changes. Notifications are coalesced, if the
+// receiver is slow only the latest one is kept. Channel is closed when Run returns.
+func (b *OrderBook) Updates() <-chan OrderBookUpdate {
+	return b.updates
+}
+
+// Run fetches a snapshot and applies diffs received from messages until ctx is cancelled or messages is closed.
+// Diffs are buffered while a snapshot is being fetched, diffs older than the snapshot are dropped. When a gap is
+// detected (out of order diff, crossed book or failed message) the book is marked as not synced and rebuilt from
+// a new snapshot. Failed snapshot requests are retried using exponential backoff while the book stays not synced
+// and are stopped when Run returns. Messages of other channels are ignored so messages can come from a shared
+// consumer. Run must be called once per order book.
+func (b *OrderBook) Run(ctx context.Context, messages <-chan WebsocketMessage) error {
+	defer close(b.updates)
+
+	// stop fetching snapshots when Run returns
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		buffer    []LiveFullOrderBook
+		snapshots = make(chan orderBookSnapshot, 1)
+		fetching  bool
+	)
+
+	resync := func() {
+		b.mu.Lock()
+		b.synced = false
+		b.mu.Unlock()
+
+		if fetching {
+			return
+		}
+		fetching = true
+
+		go func() {
+			snapshots <- b.fetchSnapshot(ctx)
+		}()
+	}
+
+	resync()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case s := <-snapshots:
+			fetching = false
+			// snapshots are retried until they succeed, an error means ctx is done
+			if s.err != nil {
+				return s.err
+			}
+
+			err := b.load(s.book, buffer)
+			buffer = nil
+			if err != nil {
+				resync()
+				continue
+			}
+			b.notify(true)
+
+		case m, ok := <-messages:
+			if !ok {
+				return ErrOrderBookStreamClosed
+			}
+
+			// a failed message might be a lost diff
+			if m.Error != nil {
+				resync()
+				continue
+			}
+
+			diff, ok := m.Message.(LiveFullOrderBook)
+			if !ok || diff.Channel != b.channel {
+				continue
+			}
+
+			if fetching {
+				buffer = append(buffer, diff)
+				continue
+			}
+
+			if err := b.apply(diff); err != nil {
+				resync()
+				continue
+			}
+			b.notify(false)
+		}
+	}
+}
+
+// fetchSnapshot requests a snapshot retrying failed requests using exponential backoff until ctx is done
+func (b *OrderBook) fetchSnapshot(ctx context.Context) orderBookSnapshot {
+	for attempt := 1; ; attempt++ {
+		book, err := b.api.GetOrderBookWithOptions(ctx, b.pair, GetOrderBookRequest{Group: OrderBookGroupByPrice})
+		if err == nil {
+			return orderBookSnapshot{book: book}
+		}
+
+		timer := time.NewTimer(b.backoff.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return orderBookSnapshot{err: ctx.Err()}
+		case <-timer.C:
+		}
+	}
+}
+
+// Synced reports whether the book is built from a snapshot and is up to date
+func (b *OrderBook) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.synced
+}
+
+// Microtimestamp returns the microtimestamp of the last applied snapshot or diff
+func (b *OrderBook) Microtimestamp() Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.microtimestamp
+}
+
+// BestBid returns the highest bid, false is returned if the book is not synced or there are no bids
+func (b *OrderBook) BestBid() (PriceLevel, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if !b.synced || len(b.bids) == 0 {
+		return PriceLevel{}, false
+	}
+
+	return b.bids[0], true
+}
+
+// BestAsk returns the lowest ask, false is returned if the book is not synced or there are no asks
+func (b *OrderBook) BestAsk() (PriceLevel, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if !b.synced || len(b.asks) == 0 {
+		return PriceLevel{}, false
+	}
+
+	return b.asks[0], true
+}
+
+// Spread returns the difference between best ask and best bid, false is returned if any of the sides is empty
+func (b *OrderBook) Spread() (Decimal, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if !b.synced || len(b.bids) == 0 || len(b.asks) == 0 {
+		return Decimal{}, false
+	}
+
+	return b.asks[0].Price.Sub(b.bids[0].Price), true
+}
+
+// Depth returns copies of the top n bids and asks sorted by best price first, if n <= 0 all levels are returned
+func (b *OrderBook) Depth(n int) ([]PriceLevel, []PriceLevel) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if !b.synced {
+		return nil, nil
+	}
+
+	return topLevels(b.bids, n), topLevels(b.asks, n)
+}
+
+// load replaces book with snapshot and applies buffered diffs that are newer than the snapshot
+func (b *OrderBook) load(snapshot *GetOrderBookResponse, buffer []LiveFullOrderBook) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.bids = make([]PriceLevel, 0, len(snapshot.Bids))
+	for i := range snapshot.Bids {
+		b.bids = setLevel(b.bids, snapshot.Bids[i].Price, snapshot.Bids[i].Amount, true)
+	}
+	b.asks = make([]PriceLevel, 0, len(snapshot.Asks))
+	for i := range snapshot.Asks {
+		b.asks = setLevel(b.asks, snapshot.Asks[i].Price, snapshot.Asks[i].Amount, false)
+	}
+	b.microtimestamp = snapshot.Microtimestamp
+
+	for i := range buffer {
+		if !buffer[i].Data.Microtimestamp.After(snapshot.Microtimestamp.Time) {
+			continue
+		}
+
+		if err := b.applyLocked(buffer[i]); err != nil {
+			return err
+		}
+	}
+	b.synced = true
+
+	return nil
+}
+
+func (b *OrderBook) apply(diff LiveFullOrderBook) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.applyLocked(diff); err != nil {
+		b.synced = false
+		return err
+	}
+
+	return nil
+}
+
+func (b *OrderBook) applyLocked(diff LiveFullOrderBook) error {
+	if diff.Data.Microtimestamp.Before(b.microtimestamp.Time) {
+		return fmt.Errorf("%w, diff %s is older than book %s", ErrOrderBookGap,
+			diff.Data.Microtimestamp.Raw, b.microtimestamp.Raw)
+	}
+
+	for i := range diff.Data.Bids {
+		b.bids = setLevel(b.bids, diff.Data.Bids[i].Price, diff.Data.Bids[i].Amount, true)
+	}
+	for i := range diff.Data.Asks {
+		b.asks = setLevel(b.asks, diff.Data.Asks[i].Price, diff.Data.Asks[i].Amount, false)
+	}
+	b.microtimestamp = diff.Data.Microtimestamp
+
+	if len(b.bids) > 0 && len(b.asks) > 0 && b.bids[0].Price.Cmp(b.asks[0].Price) >= 0 {
+		return fmt.Errorf("%w, crossed book bid %s ask %s", ErrOrderBookGap, b.bids[0].Price, b.asks[0].Price)
+	}
+
+	return nil
+}
+
+func (b *OrderBook) notify(resynced bool) {
+	u := OrderBookUpdate{Microtimestamp: b.Microtimestamp(), Resynced: resynced}
+
+	// drop the pending notification so the latest one is always delivered
+	select {
+	case <-b.updates:
+	default:
+	}
+
+	select {
+	case b.updates <- u:
+	default:
+	}
+}
+
+// setLevel updates, inserts or removes (zero amount) a price level, bids are sorted descending and asks ascending
+func setLevel(levels []PriceLevel, price, amount Decimal, desc bool) []PriceLevel {
+	i := sort.Search(len(levels), func(i int) bool {
+		if desc {
+			return levels[i].Price.Cmp(price) <= 0
+		}
+		return levels[i].Price.Cmp(price) >= 0
+	})
+
+	exists := i < len(levels) && levels[i].Price.Equal(price)
+
+	switch {
+	case amount.IsZero() && exists:
+		return append(levels[:i], levels[i+1:]...)
+	case amount.IsZero():
+		return levels
+	case exists:
+		levels[i].Amount = amount
+		return levels
+	}
+
+	levels = append(levels, PriceLevel{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = PriceLevel{Price: price, Amount: amount}
+
+	return levels
+}
+
+func topLevels(levels []PriceLevel, n int) []PriceLevel {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+
+	result := make([]PriceLevel, n)
+	copy(result, levels[:n])
+
+	return result
+}
@@ -0,0 +1,394 @@
+package bitstamp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/georlav/bitstamp"
+	"github.com/georlav/httprawmock"
+)
+
+func TestPriceLevel_JSON(t *testing.T) {
+	testCases := []struct {
+		description string
+		input       string
+		expected    string
+		expectedErr bool
+	}{
+		{description: "Should decode string values", input: `["52261.99","0.10000000"]`, expected: `["52261.99","0.10000000"]`},
+		{description: "Should decode number values", input: `[52261.99,0.1]`, expected: `["52261.99","0.1"]`},
+		{description: "Should ignore order id", input: `["1.5","2","123"]`, expected: `["1.5","2"]`},
+		{description: "Should fail on missing amount", input: `["1.5"]`, expectedErr: true},
+		{description: "Should fail on invalid price", input: `["abc","2"]`, expectedErr: true},
+		{description: "Should fail on non array value", input: `{"price":"1"}`, expectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var l bitstamp.PriceLevel
+			err := json.Unmarshal([]byte(tc.input), &l)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got %+v", l)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := json.Marshal(l)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tc.expected {
+				t.Fatalf("Expected %s got %s", tc.expected, b)
+			}
+		})
+	}
+}
+
+func TestOrderLevel_JSON(t *testing.T) {
+	testCases := []struct {
+		description     string
+		input           string
+		expected        string
+		expectedOrderID int64
+		expectedErr     bool
+	}{
+		{
+			description:     "Should decode level with string order id",
+			input:           `["52261.99","0.10000000","1432101229445121"]`,
+			expected:        `["52261.99","0.10000000","1432101229445121"]`,
+			expectedOrderID: 1432101229445121,
+		},
+		{
+			description:     "Should decode level with number order id",
+			input:           `["52261.99","0.10000000",1432101229445121]`,
+			expected:        `["52261.99","0.10000000","1432101229445121"]`,
+			expectedOrderID: 1432101229445121,
+		},
+		{
+			description: "Should decode level without order id",
+			input:       `["52261.99","0.10000000"]`,
+			expected:    `["52261.99","0.10000000"]`,
+		},
+		{
+			description: "Should fail on invalid order id",
+			input:       `["52261.99","0.10000000","abc"]`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var l bitstamp.OrderLevel
+			err := json.Unmarshal([]byte(tc.input), &l)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got %+v", l)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if l.OrderID != tc.expectedOrderID {
+				t.Fatalf("Expected order id %d got %d", tc.expectedOrderID, l.OrderID)
+			}
+
+			b, err := json.Marshal(l)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tc.expected {
+				t.Fatalf("Expected %s got %s", tc.expected, b)
+			}
+		})
+	}
+}
+
+// newOrderBookSnapshotServer serves the order book snapshot, every request blocks until gate is ready to receive
+func newOrderBookSnapshotServer(t *testing.T) (*httprawmock.Server, chan struct{}) {
+	t.Helper()
+
+	b, err := os.ReadFile("testdata/get_order_book_200.txt")
+	if err != nil {
+		t.Fatalf("failed to parse response file, %s", err)
+	}
+
+	gate := make(chan struct{})
+	ts := httprawmock.NewUnstartedServer(
+		httprawmock.NewRoute(http.MethodGet, "/api/v2/order_book/{pair}/", b),
+	)
+	next := ts.Config.Handler
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-gate:
+		case <-r.Context().Done():
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+	ts.Start()
+	t.Cleanup(ts.Close)
+
+	return ts, gate
+}
+
+func diffMessage(t *testing.T, channel string, microtimestamp string, bids string, asks string) bitstamp.WebsocketMessage {
+	t.Helper()
+
+	raw := fmt.Sprintf(
+		`{"data":{"timestamp":"%s","microtimestamp":"%s","bids":%s,"asks":%s},"channel":"%s","event":"data"}`,
+		microtimestamp[:10], microtimestamp, bids, asks, channel,
+	)
+
+	var msg bitstamp.LiveFullOrderBook
+	if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+		t.Fatalf("failed to decode diff message, %s", err)
+	}
+
+	return bitstamp.WebsocketMessage{Message: msg, RawMessage: []byte(raw)}
+}
+
+func waitOrderBookUpdate(t *testing.T, book *bitstamp.OrderBook) bitstamp.OrderBookUpdate {
+	t.Helper()
+
+	select {
+	case u := <-book.Updates():
+		return u
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for order book update")
+	}
+
+	return bitstamp.OrderBookUpdate{}
+}
+
+func TestOrderBook_Run(t *testing.T) {
+	ts, gate := newOrderBookSnapshotServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	book := bitstamp.NewOrderBook(bitstamp.NewHTTPAPI(bitstamp.BaseURLOption(ts.URL)), bitstamp.BTCUSD)
+	if book.Channel() != bitstamp.DiffOrderBookBTCUSDChannel {
+		t.Fatalf("Expected channel %s got %s", bitstamp.DiffOrderBookBTCUSDChannel, book.Channel())
+	}
+
+	messages := make(chan bitstamp.WebsocketMessage)
+	done := make(chan error, 1)
+	go func() {
+		done <- book.Run(ctx, messages)
+	}()
+
+	if _, ok := book.BestBid(); ok {
+		t.Fatal("Expected no best bid before snapshot is loaded")
+	}
+
+	// diffs received while snapshot is fetched are buffered, diffs older than snapshot are dropped
+	messages <- diffMessage(t, "diff_order_book_btcusd", "1637511612000000", `[["52261.99","0"]]`, `[]`)
+	messages <- diffMessage(t, "diff_order_book_btcusd", "1637511612184301", `[["52262.00","0.50000000"]]`, `[]`)
+	messages <- diffMessage(t, "diff_order_book_ethusd", "1637511612184302", `[["1.00","1.0"]]`, `[]`)
+	gate <- struct{}{}
+
+	if u := waitOrderBookUpdate(t, book); !u.Resynced || u.Microtimestamp.Raw != "1637511612184301" {
+		t.Fatalf("Expected resynced update at 1637511612184301 got %+v", u)
+	}
+
+	bids, asks := book.Depth(0)
+	if len(bids) != 3 || len(asks) != 2 {
+		t.Fatalf("Expected 3 bids and 2 asks got %d and %d", len(bids), len(asks))
+	}
+
+	if bid, _ := book.BestBid(); bid.Price.String() != "52262.00" {
+		t.Fatalf("Expected best bid 52262.00 got %s", bid.Price)
+	}
+
+	// remove best bid, update best ask and add a new ask level
+	messages <- diffMessage(t, "diff_order_book_btcusd", "1637511612284301",
+		`[["52262.00","0"]]`, `[["52270.00","0.07000000"],["52271.00","1.00000000"]]`)
+
+	if u := waitOrderBookUpdate(t, book); u.Resynced {
+		t.Fatalf("Expected diff update got %+v", u)
+	}
+
+	bid, _ := book.BestBid()
+	ask, _ := book.BestAsk()
+	if bid.Price.String() != "52261.99" || ask.Amount.String() != "0.07000000" {
+		t.Fatalf("Unexpected top of book, bid %+v ask %+v", bid, ask)
+	}
+
+	if spread, _ := book.Spread(); spread.String() != "8.01" {
+		t.Fatalf("Expected spread 8.01 got %s", spread)
+	}
+
+	bids, asks = book.Depth(2)
+	if len(bids) != 2 || asks[1].Price.String() != "52271.00" {
+		t.Fatalf("Unexpected depth, bids %+v asks %+v", bids, asks)
+	}
+
+	// an out of order diff is a gap, book must be rebuilt from a new snapshot
+	messages <- diffMessage(t, "diff_order_book_btcusd", "1637511612100000", `[["52000.00","1.0"]]`, `[]`)
+	gate <- struct{}{}
+
+	if u := waitOrderBookUpdate(t, book); !u.Resynced || u.Microtimestamp.Raw != "1637511612084301" {
+		t.Fatalf("Expected resynced update at 1637511612084301 got %+v", u)
+	}
+
+	if ask, _ := book.BestAsk(); ask.Amount.String() != "0.05000000" {
+		t.Fatalf("Expected best ask amount from snapshot got %s", ask.Amount)
+	}
+
+	close(messages)
+	if err := <-done; !errors.Is(err, bitstamp.ErrOrderBookStreamClosed) {
+		t.Fatalf("Expected error %s got %v", bitstamp.ErrOrderBookStreamClosed, err)
+	}
+}
+
+func TestOrderBook_Run_CrossedBook(t *testing.T) {
+	ts, gate := newOrderBookSnapshotServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	book := bitstamp.NewOrderBook(bitstamp.NewHTTPAPI(bitstamp.BaseURLOption(ts.URL)), bitstamp.BTCUSD)
+
+	messages := make(chan bitstamp.WebsocketMessage)
+	done := make(chan error, 1)
+	go func() {
+		done <- book.Run(ctx, messages)
+	}()
+
+	gate <- struct{}{}
+	waitOrderBookUpdate(t, book)
+
+	messages <- diffMessage(t, "diff_order_book_btcusd", "1637511612184301", `[["52280.00","1.0"]]`, `[]`)
+	messages <- diffMessage(t, "diff_order_book_btcusd", "1637511612284301", `[["52280.00","0"]]`, `[]`)
+
+	if book.Synced() {
+		t.Fatal("Expected book to not be synced after a crossed book")
+	}
+	if _, ok := book.Spread(); ok {
+		t.Fatal("Expected no spread while book is not synced")
+	}
+
+	gate <- struct{}{}
+	if u := waitOrderBookUpdate(t, book); !u.Resynced {
+		t.Fatalf("Expected resynced update got %+v", u)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected error %s got %v", context.Canceled, err)
+	}
+}
+
+func TestOrderBook_Run_SnapshotRetry(t *testing.T) {
+	b, err := os.ReadFile("testdata/get_order_book_200.txt")
+	if err != nil {
+		t.Fatalf("failed to parse response file, %s", err)
+	}
+
+	var requests int32
+	ts := httprawmock.NewUnstartedServer(
+		httprawmock.NewRoute(http.MethodGet, "/api/v2/order_book/{pair}/", b),
+	)
+	next := ts.Config.Handler
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+	ts.Start()
+	t.Cleanup(ts.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	book := bitstamp.NewOrderBook(bitstamp.NewHTTPAPI(bitstamp.BaseURLOption(ts.URL)), bitstamp.BTCUSD)
+
+	messages := make(chan bitstamp.WebsocketMessage)
+	done := make(chan error, 1)
+	go func() {
+		done <- book.Run(ctx, messages)
+	}()
+
+	if u := waitOrderBookUpdate(t, book); !u.Resynced || !book.Synced() {
+		t.Fatalf("Expected resynced update got %+v", u)
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Fatalf("Expected 2 snapshot requests got %d", n)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected error %s got %v", context.Canceled, err)
+	}
+}
+
+func TestOrderBook_Run_StopsSnapshotRetry(t *testing.T) {
+	requested := make(chan struct{}, 1)
+	cancelled := make(chan struct{}, 1)
+	stop := make(chan struct{})
+	ts := httprawmock.NewUnstartedServer()
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case requested <- struct{}{}:
+		default:
+		}
+
+		// hold the request until the client gives up on it
+		select {
+		case <-r.Context().Done():
+		case <-stop:
+			return
+		}
+		select {
+		case cancelled <- struct{}{}:
+		default:
+		}
+	})
+	ts.Start()
+	t.Cleanup(ts.Close)
+	// release held requests before closing the server
+	t.Cleanup(func() { close(stop) })
+
+	book := bitstamp.NewOrderBook(bitstamp.NewHTTPAPI(bitstamp.BaseURLOption(ts.URL)), bitstamp.BTCUSD)
+
+	messages := make(chan bitstamp.WebsocketMessage)
+	done := make(chan error, 1)
+	go func() {
+		done <- book.Run(context.Background(), messages)
+	}()
+
+	select {
+	case <-requested:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for snapshot request")
+	}
+
+	close(messages)
+	if err := <-done; !errors.Is(err, bitstamp.ErrOrderBookStreamClosed) {
+		t.Fatalf("Expected error %s got %v", bitstamp.ErrOrderBookStreamClosed, err)
+	}
+
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected snapshot request to be cancelled when Run returns")
+	}
+}
@@ -0,0 +1,171 @@
+package bitstamp
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// deletionRetention how long deletion markers are kept after newer events, late events of a deleted order are
+// expected to arrive well within it
+const deletionRetention = time.Minute
+
+// LiveOrder an order that is visible on the order book, built from live_orders_[currency_pair] events
+type LiveOrder struct {
+	ID             int64
+	Side           OrderSide
+	Price          Decimal
+	Amount         Decimal
+	Datetime       Time
+	Microtimestamp Time
+}
+
+// OrderTracker maintains the set of visible orders of a pair using the messages of the
+// live_orders_[currency_pair] channel. All methods are safe for concurrent use.
+type OrderTracker struct {
+	pair    Pair
+	channel string
+
+	mu     sync.RWMutex
+	orders map[int64]LiveOrder
+	// deleted microtimestamps of deleted orders so that late events do not add them back
+	deleted map[int64]time.Time
+	latest  time.Time
+}
+
+// NewOrderTracker creates an order tracker for a pair
+func NewOrderTracker(p Pair) *OrderTracker {
+	return &OrderTracker{
+		pair:    p,
+		channel: "live_orders_" + p.String(),
+		orders:  make(map[int64]LiveOrder),
+		deleted: make(map[int64]time.Time),
+	}
+}
+
+// Channel returns the websocket channel the tracker must be fed with
+func (t *OrderTracker) Channel() Channel {
+	return GetLiveOrderChannel(t.pair)
+}
+
+// Apply updates the tracked orders using an event, created and changed events add or update the order and deleted
+// events remove it. Events of other pairs, events older than the tracked state of an order and events of deleted
+// orders are ignored. Apply can be registered directly as a handler using WebsocketAPI.OnOrder.
+func (t *OrderTracker) Apply(m LiveOrdersChannel) {
+	if m.Channel != t.channel {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if o, ok := t.orders[m.Data.ID]; ok && m.Data.Microtimestamp.Before(o.Microtimestamp.Time) {
+		return
+	}
+	if _, ok := t.deleted[m.Data.ID]; ok {
+		return
+	}
+	if m.Data.Microtimestamp.After(t.latest) {
+		t.latest = m.Data.Microtimestamp.Time
+	}
+
+	switch m.Event {
+	case OrderEventCreated, OrderEventChanged:
+		t.orders[m.Data.ID] = LiveOrder{
+			ID:             m.Data.ID,
+			Side:           m.Data.OrderType,
+			Price:          m.Data.Price,
+			Amount:         m.Data.Amount,
+			Datetime:       m.Data.Datetime,
+			Microtimestamp: m.Data.Microtimestamp,
+		}
+	case OrderEventDeleted:
+		delete(t.orders, m.Data.ID)
+		t.deleted[m.Data.ID] = m.Data.Microtimestamp.Time
+		t.pruneDeleted()
+	}
+}
+
+// pruneDeleted removes deletion markers older than the retention, it must be called while holding the lock
+func (t *OrderTracker) pruneDeleted() {
+	for id, ts := range t.deleted {
+		if ts.Add(deletionRetention).Before(t.latest) {
+			delete(t.deleted, id)
+		}
+	}
+}
+
+// Run applies events received from messages until ctx is cancelled or messages is closed. Messages of other
+// channels are ignored so messages can come from a shared consumer.
+func (t *OrderTracker) Run(ctx context.Context, messages <-chan WebsocketMessage) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case m, ok := <-messages:
+			if !ok {
+				return ErrConnectionClosed
+			}
+
+			if v, ok := m.Message.(LiveOrdersChannel); ok {
+				t.Apply(v)
+			}
+		}
+	}
+}
+
+// Order returns a tracked order by id
+func (t *OrderTracker) Order(id int64) (LiveOrder, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	o, ok := t.orders[id]
+
+	return o, ok
+}
+
+// Orders returns the tracked orders of a side sorted by best price first, orders of the same price are sorted by
+// microtimestamp
+func (t *OrderTracker) Orders(side OrderSide) []LiveOrder {
+	t.mu.RLock()
+	result := make([]LiveOrder, 0, len(t.orders))
+	for _, o := range t.orders {
+		if o.Side == side {
+			result = append(result, o)
+		}
+	}
+	t.mu.RUnlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		if c := result[i].Price.Cmp(result[j].Price); c != 0 {
+			return (c > 0) == (side == OrderSideBuy)
+		}
+		if !result[i].Microtimestamp.Equal(result[j].Microtimestamp.Time) {
+			return result[i].Microtimestamp.Before(result[j].Microtimestamp.Time)
+		}
+
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// Len returns the number of tracked orders
+func (t *OrderTracker) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return len(t.orders)
+}
+
+// Reset removes all tracked orders, use it after a reconnect since events might have been lost
+func (t *OrderTracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.orders = make(map[int64]LiveOrder)
+	t.deleted = make(map[int64]time.Time)
+	t.latest = time.Time{}
+}
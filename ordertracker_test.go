@@ -0,0 +1,105 @@
+package bitstamp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/georlav/bitstamp"
+	"github.com/gorilla/websocket"
+)
+
+func liveOrderMessage(pair string, event string, id int64, side int, price string, amount string, ts int64) string {
+	return fmt.Sprintf(`{"data":{"id":%d,"id_str":"%d","order_type":%d,"datetime":"1637511612",`+
+		`"microtimestamp":"%d","amount":%s,"amount_str":"%s","price":%s,"price_str":"%s"},`+
+		`"channel":"live_orders_%s","event":"%s"}`, id, id, side, ts, amount, amount, price, price, pair, event)
+}
+
+func TestOrderTracker(t *testing.T) {
+	events := []string{
+		liveOrderMessage("btcusd", "order_created", 1, 0, "100", "1", 1637511612000001),
+		liveOrderMessage("btcusd", "order_created", 2, 0, "101", "2", 1637511612000002),
+		liveOrderMessage("btcusd", "order_created", 3, 1, "103", "1", 1637511612000003),
+		liveOrderMessage("btcusd", "order_created", 4, 1, "102", "3", 1637511612000004),
+		liveOrderMessage("btcusd", "order_changed", 2, 0, "101", "0.5", 1637511612000005),
+		liveOrderMessage("btcusd", "order_deleted", 3, 1, "103", "1", 1637511612000006),
+		// stale change must be ignored
+		liveOrderMessage("btcusd", "order_changed", 2, 0, "101", "1.5", 1637511612000004),
+		// events of deleted orders must not add them back
+		liveOrderMessage("btcusd", "order_changed", 3, 1, "103", "0.5", 1637511612000005),
+		liveOrderMessage("btcusd", "order_changed", 3, 1, "103", "0.5", 1637511612000008),
+		// other pairs must be ignored
+		liveOrderMessage("ethusd", "order_created", 5, 0, "4000", "1", 1637511612000007),
+	}
+
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		if !expectSubscribe(t, c, "live_orders_btcusd") {
+			return
+		}
+		for i := range events {
+			if err := c.WriteMessage(websocket.TextMessage, []byte(events[i])); err != nil {
+				return
+			}
+		}
+		_, _, _ = c.ReadMessage()
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(bitstamp.SetWSAddressOption(address))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tracker := bitstamp.NewOrderTracker(bitstamp.BTCUSD)
+	messages, err := ws.Consume(ctx, tracker.Channel())
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	for range events {
+		m := nextMessage(t, messages)
+		v, ok := m.Message.(bitstamp.LiveOrdersChannel)
+		if !ok {
+			t.Fatalf("Expected live order message got %+v", m)
+		}
+		tracker.Apply(v)
+	}
+
+	if tracker.Len() != 3 {
+		t.Fatalf("Expected 3 tracked orders got %d", tracker.Len())
+	}
+
+	o, ok := tracker.Order(2)
+	if !ok || o.Amount.String() != "0.5" || o.Side != bitstamp.OrderSideBuy {
+		t.Fatalf("Expected changed buy order with amount 0.5 got %+v", o)
+	}
+
+	if _, ok := tracker.Order(3); ok {
+		t.Fatal("Expected deleted order to be removed")
+	}
+
+	testCases := []struct {
+		side     bitstamp.OrderSide
+		expected []int64
+	}{
+		{side: bitstamp.OrderSideBuy, expected: []int64{2, 1}},
+		{side: bitstamp.OrderSideSell, expected: []int64{4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.side.String(), func(t *testing.T) {
+			orders := tracker.Orders(tc.side)
+			if len(orders) != len(tc.expected) {
+				t.Fatalf("Expected %d orders got %+v", len(tc.expected), orders)
+			}
+			for i := range orders {
+				if orders[i].ID != tc.expected[i] {
+					t.Fatalf("Expected order %d at position %d got %d", tc.expected[i], i, orders[i].ID)
+				}
+			}
+		})
+	}
+}
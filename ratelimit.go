@@ -0,0 +1,209 @@
+package bitstamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var ErrRateLimited = errors.New("request would exceed rate limit before context deadline")
+
+const (
+	// DefaultRateLimit max requests per DefaultRatePeriod allowed by bitstamp, exceeding it gets the IP banned
+	DefaultRateLimit  = 8000
+	DefaultRatePeriod = 10 * time.Minute
+)
+
+// EndpointClass groups endpoints that can have their own rate limit budget
+type EndpointClass int
+
+const (
+	// PublicEndpoints endpoints that do not require authentication
+	PublicEndpoints EndpointClass = iota
+	// PrivateEndpoints authenticated endpoints
+	PrivateEndpoints
+)
+
+func (c EndpointClass) String() string {
+	switch c {
+	case PublicEndpoints:
+		return "public"
+	case PrivateEndpoints:
+		return "private"
+	}
+
+	return "unknown"
+}
+
+// rateLimiterID last id assigned to a limiter, ids order the locking of multiple limiters
+var rateLimiterID uint64
+
+// defaultRateLimiter is shared by all HTTPAPI instances that do not use RateLimiterOption, the limit applies per IP
+var defaultRateLimiter = NewRateLimiter(DefaultRateLimit, DefaultRatePeriod)
+
+// RateLimiter a sliding window limiter that allows at most limit requests within any period. Requests are allowed
+// immediately until the window is full, waiting callers are served in order of arrival. It is safe for concurrent
+// use and can be shared by multiple HTTPAPI instances.
+type RateLimiter struct {
+	id     uint64
+	mu     sync.Mutex
+	limit  int
+	period time.Duration
+	// log times of allowed and reserved requests in ascending order, reservations can be in the future
+	log []time.Time
+}
+
+// NewRateLimiter creates a limiter that allows limit requests per period
+func NewRateLimiter(limit int, period time.Duration) *RateLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &RateLimiter{
+		id:     atomic.AddUint64(&rateLimiterID, 1),
+		limit:  limit,
+		period: period,
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done. When ctx has a deadline that expires before the request
+// is allowed ErrRateLimited is returned without waiting.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	return waitLimiters(ctx, l)
+}
+
+// Remaining returns the number of requests that can be made now without waiting, requests leave the window one
+// period after they were made
+func (l *RateLimiter) Remaining() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(time.Now())
+	if len(l.log) >= l.limit {
+		return 0
+	}
+
+	return l.limit - len(l.log)
+}
+
+// waitLimiters blocks until a request is allowed by all limiters or ctx is done. The request is reserved on all
+// limiters at once at the same time, so when waiting fails no limiter is charged.
+func waitLimiters(ctx context.Context, limiters ...*RateLimiter) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	limiters = uniqueLimiters(limiters)
+	if len(limiters) == 0 {
+		return nil
+	}
+
+	// lock in id order so that limiters shared by clients cannot deadlock
+	for i := range limiters {
+		limiters[i].mu.Lock()
+	}
+	now := time.Now()
+	at := now
+	for i := range limiters {
+		if t := limiters[i].earliest(now); t.After(at) {
+			at = t
+		}
+	}
+	for i := range limiters {
+		limiters[i].log = append(limiters[i].log, at)
+		limiters[i].mu.Unlock()
+	}
+
+	if !at.After(now) {
+		return nil
+	}
+
+	cancel := func() {
+		for i := range limiters {
+			limiters[i].cancel(at)
+		}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(at) {
+		cancel()
+		return fmt.Errorf("%w, wait %s", ErrRateLimited, at.Sub(now))
+	}
+
+	timer := time.NewTimer(at.Sub(now))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		return ctx.Err()
+	}
+}
+
+// uniqueLimiters returns the non nil limiters sorted by id without duplicates
+func uniqueLimiters(limiters []*RateLimiter) []*RateLimiter {
+	result := make([]*RateLimiter, 0, len(limiters))
+	for i := range limiters {
+		if limiters[i] != nil {
+			result = append(result, limiters[i])
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].id < result[j].id
+	})
+
+	unique := result[:0]
+	for i := range result {
+		if i == 0 || result[i] != result[i-1] {
+			unique = append(unique, result[i])
+		}
+	}
+
+	return unique
+}
+
+// earliest returns the earliest time a new request is allowed, it must be called while holding the lock
+func (l *RateLimiter) earliest(now time.Time) time.Time {
+	l.prune(now)
+
+	at := now
+	if n := len(l.log); n > 0 && l.log[n-1].After(at) {
+		at = l.log[n-1]
+	}
+	// the window ending at a new request must not contain limit other requests
+	if n := len(l.log); n >= l.limit {
+		if t := l.log[n-l.limit].Add(l.period); t.After(at) {
+			at = t
+		}
+	}
+
+	return at
+}
+
+// cancel removes a reservation that was not used
+func (l *RateLimiter) cancel(at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i := len(l.log) - 1; i >= 0; i-- {
+		if l.log[i].Equal(at) {
+			l.log = append(l.log[:i], l.log[i+1:]...)
+			return
+		}
+	}
+}
+
+// prune removes requests that are outside of the window, it must be called while holding the lock
+func (l *RateLimiter) prune(now time.Time) {
+	i := 0
+	for i < len(l.log) && !l.log[i].After(now.Add(-l.period)) {
+		i++
+	}
+	l.log = append(l.log[:0], l.log[i:]...)
+}
@@ -0,0 +1,163 @@
+package bitstamp_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/georlav/bitstamp"
+	"github.com/georlav/httprawmock"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	l := bitstamp.NewRateLimiter(2, 100*time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("failed to wait, %s", err)
+		}
+	}
+
+	// 2 requests are allowed immediately, third request waits for the first to leave the window
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("Expected third request to wait got %s", elapsed)
+	}
+
+	l = bitstamp.NewRateLimiter(1, time.Hour)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("failed to wait, %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, bitstamp.ErrRateLimited) {
+		t.Fatalf("Expected %s got %v", bitstamp.ErrRateLimited, err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %s got %v", context.Canceled, err)
+	}
+}
+
+func TestRateLimiter_Period(t *testing.T) {
+	const (
+		limit  = 10
+		period = 200 * time.Millisecond
+	)
+
+	l := bitstamp.NewRateLimiter(limit, period)
+	if n := l.Remaining(); n != limit {
+		t.Fatalf("Expected %d remaining requests got %d", limit, n)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), period)
+	defer cancel()
+
+	allowed := 0
+	for l.Wait(ctx) == nil {
+		allowed++
+	}
+
+	if allowed != limit {
+		t.Fatalf("Expected %d requests within one period got %d", limit, allowed)
+	}
+
+	if n := l.Remaining(); n != 0 {
+		t.Fatalf("Expected no remaining requests got %d", n)
+	}
+}
+
+func TestHTTPClient_RateLimit(t *testing.T) {
+	b, err := os.ReadFile("testdata/getticker_200.txt")
+	if err != nil {
+		t.Fatalf("failed to parse response file, %s", err)
+	}
+
+	ts := httprawmock.NewServer(
+		httprawmock.NewRoute(http.MethodGet, "/api/v2/ticker/{pair}/", b),
+	)
+	defer t.Cleanup(ts.Close)
+
+	testCases := []struct {
+		description string
+		option      func(l *bitstamp.RateLimiter) func(*bitstamp.HTTPAPI)
+	}{
+		{
+			description: "Should limit all requests",
+			option: func(l *bitstamp.RateLimiter) func(*bitstamp.HTTPAPI) {
+				return bitstamp.RateLimiterOption(l)
+			},
+		},
+		{
+			description: "Should limit public requests",
+			option: func(l *bitstamp.RateLimiter) func(*bitstamp.HTTPAPI) {
+				return bitstamp.EndpointRateLimiterOption(bitstamp.PublicEndpoints, l)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			// limiter is shared by both clients
+			l := bitstamp.NewRateLimiter(1, time.Hour)
+			c1 := bitstamp.NewHTTPAPI(bitstamp.BaseURLOption(ts.URL), tc.option(l))
+			c2 := bitstamp.NewHTTPAPI(bitstamp.BaseURLOption(ts.URL), tc.option(l))
+
+			if _, err := c1.GetTicker(context.Background(), bitstamp.BTCUSD); err != nil {
+				t.Fatalf("failed to get ticker, %s", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			if _, err := c2.GetTicker(ctx, bitstamp.BTCUSD); !errors.Is(err, bitstamp.ErrRateLimited) {
+				t.Fatalf("Expected %s got %v", bitstamp.ErrRateLimited, err)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_RateLimit_Rejected(t *testing.T) {
+	b, err := os.ReadFile("testdata/getticker_200.txt")
+	if err != nil {
+		t.Fatalf("failed to parse response file, %s", err)
+	}
+
+	ts := httprawmock.NewServer(
+		httprawmock.NewRoute(http.MethodGet, "/api/v2/ticker/{pair}/", b),
+	)
+	defer t.Cleanup(ts.Close)
+
+	public := bitstamp.NewRateLimiter(10, time.Hour)
+	c := bitstamp.NewHTTPAPI(
+		bitstamp.BaseURLOption(ts.URL),
+		bitstamp.RateLimiterOption(bitstamp.NewRateLimiter(1, time.Hour)),
+		bitstamp.EndpointRateLimiterOption(bitstamp.PublicEndpoints, public),
+	)
+
+	if _, err := c.GetTicker(context.Background(), bitstamp.BTCUSD); err != nil {
+		t.Fatalf("failed to get ticker, %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetTicker(ctx, bitstamp.BTCUSD); !errors.Is(err, bitstamp.ErrRateLimited) {
+			t.Fatalf("Expected %s got %v", bitstamp.ErrRateLimited, err)
+		}
+	}
+
+	// rejected requests must not use class budget
+	if n := public.Remaining(); n != 9 {
+		t.Fatalf("Expected 9 remaining public requests got %d", n)
+	}
+}
@@ -7,6 +7,32 @@ const (
 	SortDESC Sort = "desc"
 )
 
+// BankWithdrawalType type of a bank withdrawal
+type BankWithdrawalType string
+
+const (
+	BankWithdrawalTypeSEPA          BankWithdrawalType = "sepa"
+	BankWithdrawalTypeInternational BankWithdrawalType = "international"
+)
+
+// OrderBookGroup defines how orders of an order book are grouped
+type OrderBookGroup string
+
+const (
+	// OrderBookGroupNone orders are not grouped, each order is returned with its id
+	OrderBookGroupNone OrderBookGroup = "0"
+	// OrderBookGroupByPrice orders with the same price are grouped (default)
+	OrderBookGroupByPrice OrderBookGroup = "1"
+	// OrderBookGroupOrdersByPrice orders are returned by price, each order is returned with its id
+	OrderBookGroupOrdersByPrice OrderBookGroup = "2"
+)
+
+// GetOrderBookRequest used by GetOrderBookWithOptions method to map outgoing request data
+type GetOrderBookRequest struct {
+	// Group orders with the same price. Possible values are 0 (false), 1 (true, default) and 2 (orders by price). (optional)
+	Group OrderBookGroup `schema:"group,omitempty"`
+}
+
 // GetTransactionsRequest used by GetTransactions method to map outgoing request data
 type GetTransactionsRequest struct {
 	// The time interval from which we want the transactions to be returned. Possible values are minute, hour (default) or day.
@@ -47,7 +73,7 @@ type GetCryptoTransactionsRequest struct {
 	// Skip that many transactions before returning results (default: 0, maximum: 200000).
 	Offset int64 `schema:"offset,omitempty"`
 	// True - shows also ripple IOU transactions.
-	IncludeIOUS bool `schema:"include_ious ,omitempty"`
+	IncludeIOUS bool `schema:"include_ious,omitempty"`
 }
 
 // GetOrderStatusRequest used by GetOrderStatus method to map outgoing request data
@@ -66,10 +92,10 @@ type CancelOrderRequest struct {
 
 // CreateBuyLimitOrderRequest used by CreateBuyLimitOrder method to map outgoing request data
 type CreateBuyLimitOrderRequest struct {
-	Amount string `schema:"amount,omitempty"`
-	Price  string `schema:"price,omitempty"`
+	Amount Decimal `schema:"amount,omitempty"`
+	Price  Decimal `schema:"price,omitempty"`
 	// if the order gets executed, a new sell order will be placed, with "limit_price" as its price.
-	LimitPrice string `schema:"limit_price,omitempty"`
+	LimitPrice Decimal `schema:"limit_price,omitempty"`
 	// Opens buy limit order which will be canceled at 0:00 UTC unless it already has been executed. Possible value: True
 	DailyOrder bool `schema:"daily_order,omitempty"`
 	// An Immediate-Or-Cancel (IOC) order is an order that must be executed immediately.
@@ -86,21 +112,23 @@ type CreateBuyLimitOrderRequest struct {
 // CreateBuyInstantOrderRequest used by CreateBuyInstantOrder method to map outgoing request data
 type CreateBuyInstantOrderRequest struct {
 	// Amount in counter currency (Example: For BTC/USD pair, amount is quoted in USD)
-	Amount string `schema:"amount"`
+	Amount Decimal `schema:"amount"`
 }
 
 // CreateBuyMarketOrderRequest used by CreateBuyMarketOrder method to map outgoing request data
 type CreateBuyMarketOrderRequest struct {
 	// Amount in base currency (Example: For BTC/USD pair, amount is quoted in BTC)
-	Amount string `schema:"amount"`
+	Amount Decimal `schema:"amount"`
+	// Unique client order id set by client. Client order id needs to be unique string. Client order id value can only be used once.
+	ClientOrderID string `schema:"client_order_id,omitempty"`
 }
 
 // CreateSellLimitOrderRequest used by CreateSellLimitOrder method to map outgoing request data
 type CreateSellLimitOrderRequest struct {
-	Amount string `schema:"amount,omitempty"`
-	Price  string `schema:"price,omitempty"`
+	Amount Decimal `schema:"amount,omitempty"`
+	Price  Decimal `schema:"price,omitempty"`
 	// If the order gets executed, a new buy order will be placed, with "limit_price" as its price.
-	LimitPrice string `schema:"limit_price,omitempty"`
+	LimitPrice Decimal `schema:"limit_price,omitempty"`
 	// Opens sell limit order which will be canceled at 0:00 UTC unless it already has been executed. Possible value: True
 	DailyOrder bool `schema:"daily_order,omitempty"`
 	// An Immediate-Or-Cancel (IOC) order is an order that must be executed immediately.
@@ -114,10 +142,18 @@ type CreateSellLimitOrderRequest struct {
 	ClientOrderID string `schema:"client_order_id,omitempty"`
 }
 
+// CreateSellMarketOrderRequest used by CreateSellMarketOrder method to map outgoing request data
+type CreateSellMarketOrderRequest struct {
+	// Amount in base currency (Example: For BTC/USD pair, amount is quoted in BTC)
+	Amount Decimal `schema:"amount"`
+	// Unique client order id set by client. Client order id needs to be unique string. Client order id value can only be used once.
+	ClientOrderID string `schema:"client_order_id,omitempty"`
+}
+
 // CreateSellInstantOrderRequest used by CreateSellInstantOrder method to map outgoing request data
 type CreateSellInstantOrderRequest struct {
 	// Amount in base currency (Example: For BTC/USD pair, amount is quoted in BTC)
-	Amount string `schema:"amount"`
+	Amount Decimal `schema:"amount"`
 	// Instant sell orders allow you to sell an amount of the base currency determined by the value
 	// of it in the counter-currency. Amount_in_counter sets the amount parameter to refer to the counter
 	// currency instead of the base currency of the selected trading pair. Possible value: True
@@ -125,3 +161,107 @@ type CreateSellInstantOrderRequest struct {
 	// Unique client order id set by client. Client order id needs to be unique string. Client order id value can only be used once.
 	ClientOrderID string `schema:"client_order_id,omitempty"`
 }
+
+// GetWithdrawalRequestsRequest used by GetWithdrawalRequests method to map outgoing request data
+type GetWithdrawalRequestsRequest struct {
+	// Withdrawal requests from number of seconds ago to now (max. 50000000). (optional)
+	TimeDelta int64 `schema:"timedelta,omitempty"`
+	// Withdrawal request id, if set only that withdrawal request is returned. (optional)
+	ID int64 `schema:"id,omitempty"`
+}
+
+// CreateCryptoWithdrawalRequest used by CreateCryptoWithdrawal method to map outgoing request data
+type CreateCryptoWithdrawalRequest struct {
+	// Amount to withdraw in the currency of the withdrawal
+	Amount Decimal `schema:"amount"`
+	// Destination address
+	Address string `schema:"address"`
+	// Address memo id, required by some currencies like XLM and HBAR. (optional)
+	MemoID string `schema:"memo_id,omitempty"`
+	// Address destination tag, required by XRP. (optional)
+	DestinationTag string `schema:"destination_tag,omitempty"`
+}
+
+// TransferToMainRequest used by TransferToMain method to map outgoing request data
+type TransferToMainRequest struct {
+	// Amount to transfer
+	Amount Decimal `schema:"amount"`
+	// Currency to transfer
+	Currency Currency `schema:"currency"`
+	// The sub account unique identifier, can be omitted when request is made using a sub account API key. (optional)
+	SubAccount string `schema:"subAccount,omitempty"`
+}
+
+// TransferFromMainRequest used by TransferFromMain method to map outgoing request data
+type TransferFromMainRequest struct {
+	// Amount to transfer
+	Amount Decimal `schema:"amount"`
+	// Currency to transfer
+	Currency Currency `schema:"currency"`
+	// The sub account unique identifier
+	SubAccount string `schema:"subAccount"`
+}
+
+// OpenBankWithdrawalRequest used by OpenBankWithdrawal method to map outgoing request data
+type OpenBankWithdrawalRequest struct {
+	// Withdrawal amount
+	Amount Decimal `schema:"amount"`
+	// The balance from which you wish to withdraw (Example: usd, eur)
+	AccountCurrency Currency `schema:"account_currency"`
+	// Full user or company name
+	Name string `schema:"name"`
+	// User or company IBAN
+	IBAN string `schema:"iban"`
+	// The target bank BIC
+	BIC string `schema:"bic"`
+	// User or company address
+	Address string `schema:"address"`
+	// User or company postal code
+	PostalCode string `schema:"postal_code"`
+	// User or company city
+	City string `schema:"city"`
+	// User or company country, as ISO 3166-1 alpha-2 code (Example: GR)
+	Country string `schema:"country"`
+	// Type of the withdrawal request (sepa or international)
+	Type BankWithdrawalType `schema:"type"`
+	// Target bank name. (international withdrawals only)
+	BankName string `schema:"bank_name,omitempty"`
+	// Target bank address. (international withdrawals only)
+	BankAddress string `schema:"bank_address,omitempty"`
+	// Target bank postal code. (international withdrawals only)
+	BankPostalCode string `schema:"bank_postal_code,omitempty"`
+	// Target bank city. (international withdrawals only)
+	BankCity string `schema:"bank_city,omitempty"`
+	// Target bank country, as ISO 3166-1 alpha-2 code. (international withdrawals only)
+	BankCountry string `schema:"bank_country,omitempty"`
+	// The currency in which the funds should be withdrawn (may involve conversion fees). (international withdrawals only)
+	Currency Currency `schema:"currency,omitempty"`
+	// Withdrawal comment. (optional)
+	Comment string `schema:"comment,omitempty"`
+}
+
+// GetBankWithdrawalStatusRequest used by GetBankWithdrawalStatus method to map outgoing request data
+type GetBankWithdrawalStatusRequest struct {
+	// Withdrawal request id
+	ID int64 `schema:"id"`
+}
+
+// CancelBankWithdrawalRequest used by CancelBankWithdrawal method to map outgoing request data
+type CancelBankWithdrawalRequest struct {
+	// Withdrawal request id
+	ID int64 `schema:"id"`
+}
+
+// CreateLiquidationAddressRequest used by CreateLiquidationAddress method to map outgoing request data
+type CreateLiquidationAddressRequest struct {
+	// Deposits to the address will be converted to this currency (Example: eur, usd)
+	LiquidationCurrency Currency `schema:"liquidation_currency"`
+	// Bitcoin address format, possible values are p2sh and bech32. (optional)
+	AddressFormat string `schema:"address_format,omitempty"`
+}
+
+// GetLiquidationAddressInfoRequest used by GetLiquidationAddressInfo method to map outgoing request data
+type GetLiquidationAddressInfoRequest struct {
+	// Shows only transactions of this address, if empty transactions of all liquidation addresses are returned. (optional)
+	Address string `schema:"address,omitempty"`
+}
@@ -1,35 +1,169 @@
 package bitstamp
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UserTransactionType type of a user transaction
+type UserTransactionType int
+
+const (
+	UserTransactionTypeDeposit                  UserTransactionType = 0
+	UserTransactionTypeWithdrawal               UserTransactionType = 1
+	UserTransactionTypeMarketTrade              UserTransactionType = 2
+	UserTransactionTypeSubAccountTransfer       UserTransactionType = 14
+	UserTransactionTypeCreditedWithStakedAssets UserTransactionType = 25
+	UserTransactionTypeSentAssetsToStaking      UserTransactionType = 26
+	UserTransactionTypeStakingReward            UserTransactionType = 27
+	UserTransactionTypeReferralReward           UserTransactionType = 32
+	UserTransactionTypeInterAccountTransfer     UserTransactionType = 35
+)
+
+func (t UserTransactionType) String() string {
+	switch t {
+	case UserTransactionTypeDeposit:
+		return "deposit"
+	case UserTransactionTypeWithdrawal:
+		return "withdrawal"
+	case UserTransactionTypeMarketTrade:
+		return "market trade"
+	case UserTransactionTypeSubAccountTransfer:
+		return "sub account transfer"
+	case UserTransactionTypeCreditedWithStakedAssets:
+		return "credited with staked assets"
+	case UserTransactionTypeSentAssetsToStaking:
+		return "sent assets to staking"
+	case UserTransactionTypeStakingReward:
+		return "staking reward"
+	case UserTransactionTypeReferralReward:
+		return "referral reward"
+	case UserTransactionTypeInterAccountTransfer:
+		return "inter account transfer"
+	}
+
+	return "unknown"
+}
+
+// UnmarshalJSON type is returned as text ("2") but accept numbers as well
+func (t *UserTransactionType) UnmarshalJSON(b []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+
+	v, err := n.Int64()
+	if err != nil {
+		return err
+	}
+	*t = UserTransactionType(v)
+
+	return nil
+}
+
+// WithdrawalRequestType type of a withdrawal request
+type WithdrawalRequestType int
+
+const (
+	WithdrawalRequestTypeSEPA     WithdrawalRequestType = 0
+	WithdrawalRequestTypeBitcoin  WithdrawalRequestType = 1
+	WithdrawalRequestTypeWire     WithdrawalRequestType = 2
+	WithdrawalRequestTypeXRP      WithdrawalRequestType = 14
+	WithdrawalRequestTypeLitecoin WithdrawalRequestType = 15
+	WithdrawalRequestTypeEthereum WithdrawalRequestType = 16
+)
+
+// WithdrawalRequestStatus status of a withdrawal request
+type WithdrawalRequestStatus int
+
+const (
+	WithdrawalRequestStatusUnknown   WithdrawalRequestStatus = -1
+	WithdrawalRequestStatusOpen      WithdrawalRequestStatus = 0
+	WithdrawalRequestStatusInProcess WithdrawalRequestStatus = 1
+	WithdrawalRequestStatusFinished  WithdrawalRequestStatus = 2
+	WithdrawalRequestStatusCanceled  WithdrawalRequestStatus = 3
+	WithdrawalRequestStatusFailed    WithdrawalRequestStatus = 4
+)
+
+func (s WithdrawalRequestStatus) String() string {
+	switch s {
+	case WithdrawalRequestStatusOpen:
+		return "open"
+	case WithdrawalRequestStatusInProcess:
+		return "in process"
+	case WithdrawalRequestStatusFinished:
+		return "finished"
+	case WithdrawalRequestStatusCanceled:
+		return "canceled"
+	case WithdrawalRequestStatusFailed:
+		return "failed"
+	}
+
+	return "unknown"
+}
+
+// UnmarshalJSON withdrawal requests return status as a number while bank withdrawals as text,
+// map both formats to the same enum
+func (s *WithdrawalRequestStatus) UnmarshalJSON(b []byte) error {
+	var text string
+	if err := json.Unmarshal(b, &text); err != nil {
+		var number int
+		if err := json.Unmarshal(b, &number); err != nil {
+			return err
+		}
+		*s = WithdrawalRequestStatus(number)
+
+		return nil
+	}
+
+	*s = WithdrawalRequestStatusUnknown
+	for _, v := range []WithdrawalRequestStatus{
+		WithdrawalRequestStatusOpen,
+		WithdrawalRequestStatusInProcess,
+		WithdrawalRequestStatusFinished,
+		WithdrawalRequestStatusCanceled,
+		WithdrawalRequestStatusFailed,
+	} {
+		if strings.EqualFold(v.String(), text) {
+			*s = v
+		}
+	}
+
+	return nil
+}
 
 // TickerResponse used to map results of GetTicker, GetTickerHourly methods
 type GetTickerResponse struct {
-	High      string `json:"high"`
-	Last      string `json:"last"`
-	Timestamp string `json:"timestamp"`
-	Bid       string `json:"bid"`
-	Vwap      string `json:"vwap"`
-	Volume    string `json:"volume"`
-	Low       string `json:"low"`
-	Ask       string `json:"ask"`
-	Open      string `json:"open"`
+	High      Decimal `json:"high"`
+	Last      Decimal `json:"last"`
+	Timestamp Time    `json:"timestamp"`
+	Bid       Decimal `json:"bid"`
+	Vwap      Decimal `json:"vwap"`
+	Volume    Decimal `json:"volume"`
+	Low       Decimal `json:"low"`
+	Ask       Decimal `json:"ask"`
+	Open      Decimal `json:"open"`
 }
 
 // OrderBookResponse used to map results of GetOrderBook methods
 type GetOrderBookResponse struct {
-	Timestamp      string     `json:"timestamp"`
-	Microtimestamp string     `json:"microtimestamp"`
-	Bids           [][]string `json:"bids"`
-	Asks           [][]string `json:"asks"`
+	Timestamp      Time `json:"timestamp"`
+	Microtimestamp Time `json:"microtimestamp"`
+	// Bids sorted by best price first, order id is set when group is OrderBookGroupNone or OrderBookGroupOrdersByPrice
+	Bids []OrderLevel `json:"bids"`
+	// Asks sorted by best price first, order id is set when group is OrderBookGroupNone or OrderBookGroupOrdersByPrice
+	Asks []OrderLevel `json:"asks"`
 }
 
 // GetTransactionsResponse used by GetTransactions to map response
 type GetTransactionResponse struct {
-	TID    string `json:"tid"`
-	Type   string `json:"type"`
-	Amount string `json:"amount"`
-	Price  string `json:"price"`
-	Date   string `json:"date"`
+	TID    string  `json:"tid"`
+	Type   string  `json:"type"`
+	Amount Decimal `json:"amount"`
+	Price  Decimal `json:"price"`
+	Date   Time    `json:"date"`
 }
 
 // GetTradingPairInfoResult used to map result of GetTradingPairsInfo method
@@ -49,24 +183,129 @@ type GetOHLCDataResponse struct {
 	Data struct {
 		Pair string `json:"pair"`
 		Ohlc []struct {
-			High      string `json:"high"`
-			Timestamp string `json:"timestamp"`
-			Volume    string `json:"volume"`
-			Low       string `json:"low"`
-			Close     string `json:"close"`
-			Open      string `json:"open"`
+			High      Decimal `json:"high"`
+			Timestamp Time    `json:"timestamp"`
+			Volume    Decimal `json:"volume"`
+			Low       Decimal `json:"low"`
+			Close     Decimal `json:"close"`
+			Open      Decimal `json:"open"`
 		} `json:"ohlc"`
 	} `json:"data"`
 }
 
 // GetEURUSDConversionRate used to map result of GetEURUSDConversionRate method
 type GetEURUSDConversionRateResult struct {
-	Sell string `json:"sell"`
-	Buy  string `json:"buy"`
+	Sell Decimal `json:"sell"`
+	Buy  Decimal `json:"buy"`
 }
 
-// GetAccountBalancesResponse used to map result of GetAccountBalances method
+// GetAccountBalancesResponse used to map result of GetAccountBalance method, balances are keyed by currency so
+// currencies that are listed in the future are also mapped
 type GetAccountBalancesResponse struct {
+	// Balances per currency (Example: Balances["btc"])
+	Balances map[Currency]Balance
+	// Trading fees per pair, pairs that are not supported by the client are omitted (Example: Fees[BTCUSD])
+	Fees map[Pair]Decimal
+	// Trading fee, only returned when balance of a single pair is requested
+	Fee Decimal
+
+	raw json.RawMessage
+}
+
+// Balance balance of a single currency
+type Balance struct {
+	Available     Decimal
+	Balance       Decimal
+	Reserved      Decimal
+	WithdrawalFee Decimal
+}
+
+// UnmarshalJSON maps fields like btc_available, btc_withdrawal_fee, btcusd_fee to balances and fees, other fields
+// and values that are not decimals are ignored
+func (r *GetAccountBalancesResponse) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	r.Balances = make(map[Currency]Balance)
+	r.Fees = make(map[Pair]Decimal)
+	r.raw = append(r.raw[:0], b...)
+
+	for k, raw := range fields {
+		var (
+			c       Currency
+			balance Balance
+			v       Decimal
+		)
+
+		if err := json.Unmarshal(raw, &v); err != nil {
+			continue
+		}
+
+		switch {
+		case k == "fee":
+			r.Fee = v
+			continue
+		case strings.HasSuffix(k, "_withdrawal_fee"):
+			c = Currency(strings.TrimSuffix(k, "_withdrawal_fee"))
+			balance = r.Balances[c]
+			balance.WithdrawalFee = v
+		case strings.HasSuffix(k, "_available"):
+			c = Currency(strings.TrimSuffix(k, "_available"))
+			balance = r.Balances[c]
+			balance.Available = v
+		case strings.HasSuffix(k, "_balance"):
+			c = Currency(strings.TrimSuffix(k, "_balance"))
+			balance = r.Balances[c]
+			balance.Balance = v
+		case strings.HasSuffix(k, "_reserved"):
+			c = Currency(strings.TrimSuffix(k, "_reserved"))
+			balance = r.Balances[c]
+			balance.Reserved = v
+		case strings.HasSuffix(k, "_fee"):
+			if p, ok := getPairByName(strings.TrimSuffix(k, "_fee")); ok {
+				r.Fees[p] = v
+			}
+			continue
+		default:
+			continue
+		}
+
+		r.Balances[c] = balance
+	}
+
+	return nil
+}
+
+// Balance returns balance of a currency
+func (r GetAccountBalancesResponse) Balance(c Currency) (Balance, bool) {
+	b, ok := r.Balances[c]
+	return b, ok
+}
+
+// PairFee returns trading fee of a pair
+func (r GetAccountBalancesResponse) PairFee(p Pair) (Decimal, bool) {
+	f, ok := r.Fees[p]
+	return f, ok
+}
+
+// Legacy returns balances mapped to the LegacyAccountBalances struct
+//
+// Deprecated: LegacyAccountBalances contains only currencies listed at the time it was written, use Balances and Fees instead
+func (r GetAccountBalancesResponse) Legacy() (*LegacyAccountBalances, error) {
+	var result LegacyAccountBalances
+	if err := json.Unmarshal(r.raw, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// LegacyAccountBalances account balances as a struct with a field per currency and pair, it is kept for compatibility
+//
+// Deprecated: use GetAccountBalancesResponse Balances and Fees instead
+type LegacyAccountBalances struct {
 	AaveAvailable      string `json:"aave_available,omitempty"`
 	AaveBalance        string `json:"aave_balance,omitempty"`
 	AaveReserved       string `json:"aave_reserved,omitempty"`
@@ -383,60 +622,160 @@ type GetAccountBalancesResponse struct {
 	ZrxusdFee          string `json:"zrxusd_fee,omitempty"`
 }
 
-// GetUserTransactionResponse used to map response of GetUserTransactions method
+// GetUserTransactionResponse used to map response of GetUserTransactions method, currency amounts and
+// exchange rates are mapped dynamically so transactions of any currency can be decoded
 type GetUserTransactionResponse struct {
-	ID       int     `json:"id"`
-	OrderID  int     `json:"order_id"`
-	Type     string  `json:"type"`
-	Fee      string  `json:"fee"`
-	Datetime string  `json:"datetime,omitempty"`
-	BtcUsd   string  `json:"btc_usd,omitempty"`
-	Usd      float64 `json:"usd,omitempty"`
-	Btc      float64 `json:"btc,omitempty"`
-	Eur      string  `json:"eur,omitempty"`
-	XrpEur   float64 `json:"xrp_eur,omitempty"`
-	XrpUsd   float64 `json:"xrp_usd,omitempty"`
-	Xrp      string  `json:"xrp,omitempty"`
-	Eth      string  `json:"eth,omitempty"`
-	EthEur   float64 `json:"eth_eur,omitempty"`
-	EthUsd   float64 `json:"eth_usd,omitempty"`
-	Xlm      string  `json:"xlm,omitempty"`
-	XlmEur   float64 `json:"xlm_eur,omitempty"`
-	XlmUsd   float64 `json:"xlm_usd,omitempty"`
-	Omg      string  `json:"omg,omitempty"`
-	OmgEur   float64 `json:"omg_eur,omitempty"`
-	OmgUsd   float64 `json:"omg_usd,omitempty"`
-	ZrxEur   float64 `json:"zrx_eur,omitempty"`
-	ZrxUsd   float64 `json:"zrx_usd,omitempty"`
-	Zrx      string  `json:"zrx,omitempty"`
+	ID       int64
+	OrderID  int64
+	Type     UserTransactionType
+	Fee      Decimal
+	Datetime Time
+	// Amounts per currency, negative amounts are debited (Example: Amounts["btc"])
+	Amounts map[Currency]Decimal
+	// Exchange rates per pair url symbol, mapped from fields like btc_usd (Example: Rates["btcusd"])
+	Rates map[string]Decimal
+}
+
+// UnmarshalJSON maps known fields and collects currency amounts and exchange rates. Keys of currencies (Example:
+// "btc") and pairs (Example: "btc_usd") supported by the client must hold decimals, keys shaped like unknown
+// currencies and pairs are collected only if they hold decimals and other fields are ignored.
+func (t *GetUserTransactionResponse) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	t.Amounts = make(map[Currency]Decimal)
+	t.Rates = make(map[string]Decimal)
+
+	for k, v := range fields {
+		var dst interface{}
+
+		switch {
+		case k == "id":
+			dst = &t.ID
+		case k == "order_id":
+			dst = &t.OrderID
+		case k == "type":
+			dst = &t.Type
+		case k == "fee":
+			dst = &t.Fee
+		case k == "datetime":
+			dst = &t.Datetime
+		case isPairKey(k):
+			name := strings.ReplaceAll(k, "_", "")
+			_, known := getPairByName(name)
+
+			var rate Decimal
+			if err := json.Unmarshal(v, &rate); err != nil {
+				if known {
+					return fmt.Errorf("failed to decode rate %s, %w", k, err)
+				}
+				continue
+			}
+			t.Rates[name] = rate
+			continue
+		case isCurrencyKey(k):
+			_, known := knownCurrencies[Currency(k)]
+
+			var amount Decimal
+			if err := json.Unmarshal(v, &amount); err != nil {
+				if known {
+					return fmt.Errorf("failed to decode amount %s, %w", k, err)
+				}
+				continue
+			}
+			t.Amounts[Currency(k)] = amount
+			continue
+		default:
+			continue
+		}
+
+		if err := json.Unmarshal(v, dst); err != nil {
+			return fmt.Errorf("failed to decode %s, %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// isCurrencyKey reports whether a key looks like a currency code, a lowercase letter followed by lowercase letters
+// or digits
+func isCurrencyKey(k string) bool {
+	if len(k) < 2 || len(k) > 10 || k[0] < 'a' || k[0] > 'z' {
+		return false
+	}
+
+	for i := range k {
+		if (k[i] < 'a' || k[i] > 'z') && (k[i] < '0' || k[i] > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPairKey reports whether a key looks like two currency codes joined by an underscore
+func isPairKey(k string) bool {
+	parts := strings.Split(k, "_")
+	return len(parts) == 2 && isCurrencyKey(parts[0]) && isCurrencyKey(parts[1])
+}
+
+// Pair returns the pair of a market trade, the pair is detected using the non zero exchange rates of the trade
+// that belong to supported pairs, checked in alphabetical order
+func (t GetUserTransactionResponse) Pair() (Pair, bool) {
+	names := make([]string, 0, len(t.Rates))
+	for k, v := range t.Rates {
+		if !v.IsZero() {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if p, ok := getPairByName(name); ok {
+			return p, true
+		}
+	}
+
+	return NILNIL, false
 }
 
 // GetCryptoTransactionsResponse used to map response of GetCryptoTransactions method
 type GetCryptoTransactionsResponse struct {
-	Deposits []struct {
-		Currency           string `json:"currency,"`
-		DestinationAddress string `json:"destination_address,"`
-		TXID               string `json:"txid,"`
-		Amount             string `json:"amount,"`
-		Datetime           string `json:"datetime,"`
-	} `json:"deposits"`
-	Withdrawals []struct {
-		Currency           string `json:"currency,"`
-		DestinationAddress string `json:"destination_address,"`
-		TXID               string `json:"txid,"`
-		Amount             string `json:"amount,"`
-		Datetime           string `json:"datetime,"`
-	} `json:"withdrawals"`
+	Deposits    []CryptoTransaction `json:"deposits"`
+	Withdrawals []CryptoTransaction `json:"withdrawals"`
+	// Only returned if request was made with IncludeIOUS
+	RippleIOUTransactions []CryptoTransaction `json:"ripple_iou_transactions,omitempty"`
+}
+
+// CryptoTransaction a crypto deposit or withdrawal as returned by GetCryptoTransactions method
+type CryptoTransaction struct {
+	Currency           string  `json:"currency"`
+	Network            string  `json:"network,omitempty"`
+	DestinationAddress string  `json:"destinationAddress"`
+	TXID               string  `json:"txid"`
+	Amount             Decimal `json:"amount"`
+	Datetime           Time    `json:"datetime"`
+}
+
+// GetCryptoDepositAddressResponse used to map response of GetCryptoDepositAddress method
+type GetCryptoDepositAddressResponse struct {
+	Address string `json:"address"`
+	// Destination tag, only returned for XRP
+	DestinationTag int64 `json:"destination_tag,omitempty"`
+	// Memo id, only returned for currencies that use memos like XLM and HBAR
+	MemoID string `json:"memo_id,omitempty"`
 }
 
 // GetOpenOrderResponse used to map response of GetOpenOrders method
 type GetOpenOrderResponse struct {
-	ID           string `json:"id"`
-	Type         string `json:"type"`
-	Price        string `json:"price"`
-	CurrencyPair string `json:"currency_pair"`
-	Datetime     string `json:"datetime"`
-	Amount       string `json:"amount"`
+	ID           string  `json:"id"`
+	Type         string  `json:"type"`
+	Price        Decimal `json:"price"`
+	CurrencyPair string  `json:"currency_pair"`
+	Datetime     Time    `json:"datetime"`
+	Amount       Decimal `json:"amount"`
 }
 
 // GetOpenOrderResponse used to map response of GetOpenOrders method
@@ -444,16 +783,16 @@ type GetOrderStatusResponse struct {
 	ID           int64  `json:"id"`
 	Status       string `json:"status"`
 	Transactions []struct {
-		TID      string `json:"tid"`
-		USD      string `json:"usd"`
-		Price    string `json:"price"`
-		Fee      string `json:"fee"`
-		Btc      string `json:"btc"`
-		Datetime string `json:"datetime"`
+		TID      string  `json:"tid"`
+		USD      Decimal `json:"usd"`
+		Price    Decimal `json:"price"`
+		Fee      Decimal `json:"fee"`
+		Btc      Decimal `json:"btc"`
+		Datetime Time    `json:"datetime"`
 		// (0 - deposit; 1 - withdrawal; 2 - market trade).
 		Type string `json:"type"`
 	} `json:"transactions"`
-	AmountRemaining string `json:"amount_remaining"`
+	AmountRemaining Decimal `json:"amount_remaining"`
 	// Client order id. (Only returned if order was placed with client order id parameter.)
 	ClientOrderID *string `json:"client_order_id"`
 }
@@ -462,8 +801,8 @@ type GetOrderStatusResponse struct {
 type CancelOrderResponse struct {
 	ID     int64   `json:"id"`
 	Type   int     `json:"type"`
-	Amount float64 `json:"amount"`
-	Price  int     `json:"price"`
+	Amount Decimal `json:"amount"`
+	Price  Decimal `json:"price"`
 }
 
 // CancelAllOrdersResponse used to map response of CancelAllOrders method
@@ -472,8 +811,8 @@ type CancelAllOrdersResponse struct {
 		ID           int64   `json:"id"`
 		CurrencyPair string  `json:"currency_pair"`
 		Type         int     `json:"type"`
-		Amount       float64 `json:"amount"`
-		Price        int     `json:"price"`
+		Amount       Decimal `json:"amount"`
+		Price        Decimal `json:"price"`
 	} `json:"canceled"`
 	Success bool `json:"success"`
 }
@@ -483,17 +822,112 @@ type CancelAllOrdersResponse struct {
 // CreateBuyMarketOrder, CreateSellMarketOrder
 // CreateBuyInstantOrder, CreateSellInstantOrder
 type CreateOrderResponse struct {
-	ID       string `json:"id"`
-	Type     string `json:"type"`
-	Price    string `json:"price"`
-	Amount   string `json:"amount"`
-	Datetime string `json:"datetime"`
+	ID       string  `json:"id"`
+	Type     string  `json:"type"`
+	Price    Decimal `json:"price"`
+	Amount   Decimal `json:"amount"`
+	Datetime Time    `json:"datetime"`
+}
+
+// GetWithdrawalRequestResponse used to map response of GetWithdrawalRequests method
+type GetWithdrawalRequestResponse struct {
+	ID       int64                   `json:"id"`
+	Datetime Time                    `json:"datetime"`
+	Type     WithdrawalRequestType   `json:"type"`
+	Currency string                  `json:"currency"`
+	Network  string                  `json:"network"`
+	Amount   Decimal                 `json:"amount"`
+	Status   WithdrawalRequestStatus `json:"status"`
+	// Destination address, only returned for crypto withdrawals
+	Address string `json:"address,omitempty"`
+	// Transaction id, only returned for crypto withdrawals
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Transaction id on the blockchain, only returned for crypto withdrawals that have been sent
+	TXID string `json:"txid,omitempty"`
+}
+
+// CreateCryptoWithdrawalResponse used to map response of CreateCryptoWithdrawal method
+type CreateCryptoWithdrawalResponse struct {
+	// Withdrawal id
+	ID int64 `json:"id"`
+}
+
+// TransferResponse used to map response of TransferToMain, TransferFromMain methods
+type TransferResponse struct {
+	// ok or error
+	Status string `json:"status"`
+	// Only returned if status is error
+	Reason json.RawMessage `json:"reason,omitempty"`
+}
+
+// OpenBankWithdrawalResponse used to map response of OpenBankWithdrawal method
+type OpenBankWithdrawalResponse struct {
+	// Withdrawal request id
+	WithdrawalID int64 `json:"withdrawal_id"`
+}
+
+// GetBankWithdrawalStatusResponse used to map response of GetBankWithdrawalStatus method
+type GetBankWithdrawalStatusResponse struct {
+	Status WithdrawalRequestStatus `json:"status"`
+	// Reason for the withdrawal request failure, if any
+	Reason string `json:"reason,omitempty"`
+	// Transaction id of the withdrawal, if the withdrawal request is finished
+	TransactionID string `json:"transaction_id,omitempty"`
+}
+
+// CancelBankWithdrawalResponse used to map response of CancelBankWithdrawal method
+type CancelBankWithdrawalResponse struct {
+	// Withdrawal request id
+	ID              int64   `json:"id"`
+	Amount          Decimal `json:"amount"`
+	Currency        string  `json:"currency"`
+	AccountCurrency string  `json:"account_currency"`
+	// sepa or international
+	Type BankWithdrawalType `json:"type"`
+}
+
+// CreateLiquidationAddressResponse used to map response of CreateLiquidationAddress method
+type CreateLiquidationAddressResponse struct {
+	// Bitcoin deposit address
+	Address string `json:"address"`
+}
+
+// GetLiquidationAddressInfoResponse used to map response of GetLiquidationAddressInfo method
+type GetLiquidationAddressInfoResponse struct {
+	Address      string `json:"address"`
+	CurrencyPair string `json:"currency_pair"`
+	// Deposits to the address, each one liquidated by an order
+	Transactions []struct {
+		OrderID int64 `json:"order_id"`
+		// Number of trades executed for the order
+		Count  int `json:"count"`
+		Trades []struct {
+			ExchangeRate Decimal `json:"exchange_rate"`
+			BtcAmount    Decimal `json:"btc_amount"`
+			Fees         Decimal `json:"fees"`
+		} `json:"trades"`
+	} `json:"transactions"`
+}
+
+// GetTradingFeeResponse used to map response of GetTradingFee, GetTradingFees methods
+type GetTradingFeeResponse struct {
+	CurrencyPair string `json:"currency_pair"`
+	Fees         Fee    `json:"fees"`
+}
+
+// Fee trading fees of a pair as a percentage (Example: 0.16 means 0.16%)
+type Fee struct {
+	Maker Decimal `json:"maker"`
+	Taker Decimal `json:"taker"`
 }
 
 // GetWebsocketTokenResponse use to map response of GetWebsocketToken method
 type GetWebsocketTokenResponse struct {
-	Token        string `json:"token"`
-	ValidSeconds string `json:"valid_sec"`
+	Token string `json:"token"`
+	// ValidSeconds token validity in seconds, token must be used for subscribing before it expires
+	ValidSeconds json.Number `json:"valid_sec"`
+	// UserID is used as suffix of private channel names
+	UserID json.Number `json:"user_id"`
 }
 
 // GenericErrorResponse errors are not using a unified format trying to map them all kind of error responses at a generic object
@@ -1,16 +1,44 @@
 package bitstamp
 
+// OrderEvent kind of a live order event
+type OrderEvent string
+
+const (
+	OrderEventCreated OrderEvent = "order_created"
+	OrderEventChanged OrderEvent = "order_changed"
+	OrderEventDeleted OrderEvent = "order_deleted"
+)
+
+// OrderSide side of a live order, mapped from order_type
+type OrderSide int
+
+const (
+	OrderSideBuy  OrderSide = 0
+	OrderSideSell OrderSide = 1
+)
+
+func (s OrderSide) String() string {
+	switch s {
+	case OrderSideBuy:
+		return "buy"
+	case OrderSideSell:
+		return "sell"
+	}
+
+	return "unknown"
+}
+
 // LiveTickerChannel object to map messages from live_trades_[currency_pair] channel
 type LiveTickerChannel struct {
 	Data struct {
 		ID             int     `json:"id"`
-		Timestamp      string  `json:"timestamp"`
-		Amount         float64 `json:"amount"`
-		AmountStr      string  `json:"amount_str"`
-		Price          float64 `json:"price"`
-		PriceStr       string  `json:"price_str"`
+		Timestamp      Time    `json:"timestamp"`
+		Amount         Decimal `json:"amount"`
+		AmountStr      Decimal `json:"amount_str"`
+		Price          Decimal `json:"price"`
+		PriceStr       Decimal `json:"price_str"`
 		Type           int     `json:"type"`
-		Microtimestamp string  `json:"microtimestamp"`
+		Microtimestamp Time    `json:"microtimestamp"`
 		BuyOrderID     int64   `json:"buy_order_id"`
 		SellOrderID    int64   `json:"sell_order_id"`
 	} `json:"data"`
@@ -20,16 +48,50 @@ type LiveTickerChannel struct {
 
 // LiveOrdersChannel object to map messages from live_orders_[currency_pair] channel
 type LiveOrdersChannel struct {
+	Data struct {
+		ID             int64     `json:"id"`
+		IDStr          string    `json:"id_str"`
+		OrderType      OrderSide `json:"order_type"`
+		Datetime       Time      `json:"datetime"`
+		Microtimestamp Time      `json:"microtimestamp"`
+		Amount         Decimal   `json:"amount"`
+		AmountStr      Decimal   `json:"amount_str"`
+		Price          Decimal   `json:"price"`
+		PriceStr       Decimal   `json:"price_str"`
+	} `json:"data"`
+	Channel string     `json:"channel"`
+	Event   OrderEvent `json:"event"`
+}
+
+// LiveMyOrdersChannel object to map messages from private-my_orders_[currency_pair] channel
+type LiveMyOrdersChannel struct {
+	Data struct {
+		ID             int64     `json:"id"`
+		IDStr          string    `json:"id_str"`
+		ClientOrderID  string    `json:"client_order_id"`
+		OrderType      OrderSide `json:"order_type"`
+		Datetime       Time      `json:"datetime"`
+		Microtimestamp Time      `json:"microtimestamp"`
+		Amount         Decimal   `json:"amount"`
+		AmountStr      Decimal   `json:"amount_str"`
+		Price          Decimal   `json:"price"`
+		PriceStr       Decimal   `json:"price_str"`
+	} `json:"data"`
+	Channel string     `json:"channel"`
+	Event   OrderEvent `json:"event"`
+}
+
+// LiveMyTradesChannel object to map messages from private-my_trades_[currency_pair] channel
+type LiveMyTradesChannel struct {
 	Data struct {
 		ID             int64   `json:"id"`
-		IDStr          string  `json:"id_str"`
-		OrderType      int     `json:"order_type"`
-		Datetime       string  `json:"datetime"`
-		Microtimestamp string  `json:"microtimestamp"`
-		Amount         float64 `json:"amount"`
-		AmountStr      string  `json:"amount_str"`
-		Price          float64 `json:"price"`
-		PriceStr       string  `json:"price_str"`
+		OrderID        int64   `json:"order_id"`
+		ClientOrderID  string  `json:"client_order_id"`
+		Amount         Decimal `json:"amount"`
+		Price          Decimal `json:"price"`
+		Fee            Decimal `json:"fee"`
+		Side           string  `json:"side"`
+		Microtimestamp Time    `json:"microtimestamp"`
 	} `json:"data"`
 	Channel string `json:"channel"`
 	Event   string `json:"event"`
@@ -38,12 +100,12 @@ type LiveOrdersChannel struct {
 // LiveOrderBookChannel object to map messages from order_book_[currency_pair] channel
 type LiveOrderBookChannel struct {
 	Data struct {
-		Timestamp      string `json:"timestamp"`
-		Microtimestamp string `json:"microtimestamp"`
+		Timestamp      Time `json:"timestamp"`
+		Microtimestamp Time `json:"microtimestamp"`
 		// List of top 100 bids
-		Bids [][]string `json:"bids"`
+		Bids []PriceLevel `json:"bids"`
 		// List of top 100 asks
-		Asks [][]string `json:"asks"`
+		Asks []PriceLevel `json:"asks"`
 	} `json:"data"`
 	Channel string `json:"channel"`
 	Event   string `json:"event"`
@@ -52,12 +114,12 @@ type LiveOrderBookChannel struct {
 // LiveDetailOrderBookChannel object to map messages from detail_order_book_[currency_pair] channel
 type LiveDetailOrderBookChannel struct {
 	Data struct {
-		Timestamp      string `json:"timestamp"`
-		Microtimestamp string `json:"microtimestamp"`
+		Timestamp      Time `json:"timestamp"`
+		Microtimestamp Time `json:"microtimestamp"`
 		// List of top 100 bids [price, amount, order id].
-		Bids [][]string `json:"bids"`
+		Bids []OrderLevel `json:"bids"`
 		// List of top 100 asks [price, amount, order id].
-		Asks [][]string `json:"asks"`
+		Asks []OrderLevel `json:"asks"`
 	} `json:"data"`
 	Channel string `json:"channel"`
 	Event   string `json:"event"`
@@ -66,12 +128,12 @@ type LiveDetailOrderBookChannel struct {
 // LiveFullOrderBook object to map messages from diff_order_book_[currency_pair] channel
 type LiveFullOrderBook struct {
 	Data struct {
-		Timestamp      string `json:"timestamp"`
-		Microtimestamp string `json:"microtimestamp"`
-		// List of changed bids since last broadcast.
-		Bids [][]string `json:"bids"`
-		// List of changed asks since last broadcast.
-		Asks [][]string `json:"asks"`
+		Timestamp      Time `json:"timestamp"`
+		Microtimestamp Time `json:"microtimestamp"`
+		// List of changed bids since last broadcast, a zero amount means the price level was removed.
+		Bids []PriceLevel `json:"bids"`
+		// List of changed asks since last broadcast, a zero amount means the price level was removed.
+		Asks []PriceLevel `json:"asks"`
 	} `json:"data"`
 	Channel string `json:"channel"`
 	Event   string `json:"event"`
@@ -0,0 +1,269 @@
+package bitstamp
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrSubscriptionFailed = errors.New("subscription failed")
+
+// SubscriptionState state of a channel subscription
+type SubscriptionState int
+
+const (
+	// SubscriptionPending subscription request is sent but not yet confirmed by the server
+	SubscriptionPending SubscriptionState = iota
+	// SubscriptionActive server confirmed the subscription (bts:subscription_succeeded)
+	SubscriptionActive
+	// SubscriptionFailed server rejected the subscription (bts:error)
+	SubscriptionFailed
+)
+
+func (s SubscriptionState) String() string {
+	switch s {
+	case SubscriptionPending:
+		return "pending"
+	case SubscriptionActive:
+		return "active"
+	case SubscriptionFailed:
+		return "failed"
+	}
+
+	return "unknown"
+}
+
+// Subscription a tracked channel subscription
+type Subscription struct {
+	Channel Channel
+	State   SubscriptionState
+	// Err is set when state is SubscriptionFailed
+	Err error
+}
+
+// SubscriptionError returned when the server rejects a subscribe or unsubscribe request, matches
+// ErrSubscriptionFailed using errors.Is
+type SubscriptionError struct {
+	// Channel name as sent to the server, private channels are suffixed with the user id
+	Channel string
+	Message string
+}
+
+func (e SubscriptionError) Error() string {
+	return fmt.Sprintf("%s, channel %s, %s", ErrSubscriptionFailed, e.Channel, e.Message)
+}
+
+func (e SubscriptionError) Is(target error) bool {
+	return target == ErrSubscriptionFailed
+}
+
+// subscriptionKey identifies a pending request by the channel name as sent to the server
+type subscriptionKey struct {
+	name        string
+	unsubscribe bool
+}
+
+// subscriptionWaiter a caller waiting for the response of a request
+type subscriptionWaiter struct {
+	key subscriptionKey
+	ch  chan error
+}
+
+// subscriptions tracks subscriptions and the callers that wait for their confirmation
+type subscriptions struct {
+	mu    sync.Mutex
+	subs  map[Channel]*Subscription
+	names map[Channel]string
+	// pending requests sent to the server and not yet answered in order of request
+	pending []subscriptionKey
+	// waiters in order of request
+	waiters []subscriptionWaiter
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{
+		subs:  make(map[Channel]*Subscription),
+		names: make(map[Channel]string),
+	}
+}
+
+// add tracks channel as pending, if wait is true returns a channel that receives the server response
+func (s *subscriptions) add(c Channel, name string, wait bool) <-chan error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subs[c] = &Subscription{Channel: c, State: SubscriptionPending}
+	s.names[c] = name
+
+	return s.request(subscriptionKey{name: name}, wait)
+}
+
+// remove stops tracking channel, if wait is true returns a channel that receives the server response
+func (s *subscriptions) remove(c Channel, name string, wait bool) <-chan error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subs, c)
+	delete(s.names, c)
+
+	return s.request(subscriptionKey{name: name, unsubscribe: true}, wait)
+}
+
+// discard forgets a request that could not be sent, a channel that failed to subscribe is no longer tracked
+func (s *subscriptions) discard(c Channel, name string, unsubscribe bool, ch <-chan error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := subscriptionKey{name: name, unsubscribe: unsubscribe}
+	for i := len(s.pending) - 1; i >= 0; i-- {
+		if s.pending[i] == key {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			break
+		}
+	}
+
+	if !unsubscribe {
+		delete(s.subs, c)
+		delete(s.names, c)
+	}
+
+	s.removeWaiter(ch)
+}
+
+// cancel stops waiting for a response, the request stays pending since the server will still answer it
+func (s *subscriptions) cancel(ch <-chan error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeWaiter(ch)
+}
+
+// reset forgets pending requests, used after reconnecting since requests of a lost connection are never answered
+func (s *subscriptions) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = nil
+}
+
+// request must be called while holding the lock
+func (s *subscriptions) request(key subscriptionKey, wait bool) <-chan error {
+	s.pending = append(s.pending, key)
+	if !wait {
+		return nil
+	}
+
+	ch := make(chan error, 1)
+	s.waiters = append(s.waiters, subscriptionWaiter{key: key, ch: ch})
+
+	return ch
+}
+
+// handle updates subscription state using a server event and notifies waiting callers
+func (s *subscriptions) handle(event string, name string, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch event {
+	case "bts:subscription_succeeded":
+		key := subscriptionKey{name: name}
+		if sub := s.find(name); sub != nil {
+			sub.State, sub.Err = SubscriptionActive, nil
+		}
+		s.answer(key)
+		s.notify(key, nil, false)
+
+	case "bts:unsubscription_succeeded":
+		key := subscriptionKey{name: name, unsubscribe: true}
+		s.answer(key)
+		s.notify(key, nil, false)
+
+	case "bts:error":
+		// errors often do not state the channel, they are assumed to refer to the oldest pending request
+		if name == "" {
+			if len(s.pending) == 0 {
+				return
+			}
+			key := s.pending[0]
+			s.pending = s.pending[1:]
+
+			err := SubscriptionError{Channel: key.name, Message: message}
+			if sub := s.find(key.name); sub != nil && !key.unsubscribe {
+				sub.State, sub.Err = SubscriptionFailed, err
+			}
+			s.notify(key, err, true)
+
+			return
+		}
+
+		err := SubscriptionError{Channel: name, Message: message}
+		if sub := s.find(name); sub != nil {
+			sub.State, sub.Err = SubscriptionFailed, err
+		}
+
+		// errors do not state the request they refer to
+		for _, key := range []subscriptionKey{{name: name}, {name: name, unsubscribe: true}} {
+			s.answer(key)
+			s.notify(key, err, false)
+		}
+	}
+}
+
+// find returns the subscription of a channel name, it must be called while holding the lock
+func (s *subscriptions) find(name string) *Subscription {
+	for c, n := range s.names {
+		if n == name {
+			return s.subs[c]
+		}
+	}
+
+	return nil
+}
+
+// answer removes the oldest pending request of key, it must be called while holding the lock
+func (s *subscriptions) answer(key subscriptionKey) {
+	for i := range s.pending {
+		if s.pending[i] == key {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// notify sends err to the waiters of key or only to the oldest one, it must be called while holding the lock
+func (s *subscriptions) notify(key subscriptionKey, err error, oldest bool) {
+	waiters := s.waiters[:0]
+	notified := false
+	for _, w := range s.waiters {
+		if w.key == key && !(oldest && notified) {
+			w.ch <- err
+			notified = true
+			continue
+		}
+		waiters = append(waiters, w)
+	}
+	s.waiters = waiters
+}
+
+// removeWaiter must be called while holding the lock
+func (s *subscriptions) removeWaiter(ch <-chan error) {
+	for i := range s.waiters {
+		if s.waiters[i].ch == ch {
+			s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// list returns a copy of all tracked subscriptions
+func (s *subscriptions) list() []Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]Subscription, 0, len(s.subs))
+	for _, sub := range s.subs {
+		result = append(result, *sub)
+	}
+
+	return result
+}
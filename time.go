@@ -0,0 +1,85 @@
+package bitstamp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidTime = errors.New("invalid time")
+
+// datetime layouts used by bitstamp, all of them are in UTC
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02 15:04:05",
+	time.RFC3339Nano,
+}
+
+// Time a timestamp as returned by bitstamp, it can be decoded from unix timestamps in seconds, milliseconds or
+// microseconds (both as JSON strings or numbers) and from datetimes like "2006-01-02 15:04:05.000000".
+// Time is always in UTC and Raw keeps the value exactly as it was received.
+type Time struct {
+	time.Time
+	Raw string
+}
+
+// ParseTime parses any of the timestamp formats used by bitstamp
+func ParseTime(s string) (Time, error) {
+	if s == "" {
+		return Time{}, fmt.Errorf("%w, empty string", ErrInvalidTime)
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		var t time.Time
+
+		// detect precision using the number of digits
+		switch l := len(s); {
+		case l <= 11:
+			t = time.Unix(n, 0)
+		case l <= 14:
+			t = time.UnixMilli(n)
+		case l <= 17:
+			t = time.UnixMicro(n)
+		default:
+			t = time.Unix(0, n)
+		}
+
+		return Time{Time: t.UTC(), Raw: s}, nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return Time{Time: t.UTC(), Raw: s}, nil
+		}
+	}
+
+	return Time{}, fmt.Errorf("%w `%s`", ErrInvalidTime, s)
+}
+
+// MarshalJSON encodes time using its raw value, if there is none RFC3339 is used
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.Raw != "" {
+		return []byte(strconv.Quote(t.Raw)), nil
+	}
+
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON decodes time from both JSON strings and numbers, null and empty strings are decoded as zero time
+func (t *Time) UnmarshalJSON(b []byte) error {
+	b = bytes.Trim(b, `"`)
+	if len(b) == 0 || string(b) == "null" {
+		*t = Time{}
+		return nil
+	}
+
+	v, err := ParseTime(string(b))
+	if err != nil {
+		return err
+	}
+	*t = v
+
+	return nil
+}
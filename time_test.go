@@ -0,0 +1,96 @@
+package bitstamp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/georlav/bitstamp"
+)
+
+func TestParseTime(t *testing.T) {
+	testCases := []struct {
+		description string
+		input       string
+		expected    time.Time
+		err         error
+	}{
+		{
+			description: "Should parse unix timestamp",
+			input:       "1637503449",
+			expected:    time.Date(2021, 11, 21, 14, 4, 9, 0, time.UTC),
+		},
+		{
+			description: "Should parse unix timestamp in milliseconds",
+			input:       "1637503449123",
+			expected:    time.Date(2021, 11, 21, 14, 4, 9, 123000000, time.UTC),
+		},
+		{
+			description: "Should parse microtimestamp",
+			input:       "1637503449123456",
+			expected:    time.Date(2021, 11, 21, 14, 4, 9, 123456000, time.UTC),
+		},
+		{
+			description: "Should parse datetime with microseconds",
+			input:       "2021-11-21 21:21:21.123456",
+			expected:    time.Date(2021, 11, 21, 21, 21, 21, 123456000, time.UTC),
+		},
+		{
+			description: "Should parse datetime",
+			input:       "2021-11-19 09:12:44",
+			expected:    time.Date(2021, 11, 19, 9, 12, 44, 0, time.UTC),
+		},
+		{
+			description: "Should fail to parse invalid time",
+			input:       "yesterday",
+			err:         bitstamp.ErrInvalidTime,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			result, err := bitstamp.ParseTime(tc.input)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("Expected error %v got %v", tc.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !result.Equal(tc.expected) || result.Location() != time.UTC {
+				t.Fatalf("Expected time to be %s got %s", tc.expected, result.Time)
+			}
+
+			if result.Raw != tc.input {
+				t.Fatalf("Expected raw value to be %s got %s", tc.input, result.Raw)
+			}
+		})
+	}
+}
+
+func TestTime_JSON(t *testing.T) {
+	var v struct {
+		String bitstamp.Time `json:"string"`
+		Number bitstamp.Time `json:"number"`
+		Null   bitstamp.Time `json:"null"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"string": "1637503449123456", "number": 1637313164, "null": null}`), &v); err != nil {
+		t.Fatalf("Failed to decode times, %s", err)
+	}
+
+	if v.String.UnixMicro() != 1637503449123456 || v.Number.Unix() != 1637313164 || !v.Null.IsZero() {
+		t.Fatalf("Unexpected decoded times %+v", v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to encode times, %s", err)
+	}
+
+	if string(b) != `{"string":"1637503449123456","number":"1637313164","null":"0001-01-01T00:00:00Z"}` {
+		t.Fatalf("Unexpected encoded times %s", b)
+	}
+}
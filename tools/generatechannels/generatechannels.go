@@ -26,8 +26,8 @@ var channelNames = []string{
 	"order_book",
 	"detail_order_book",
 	"diff_order_book",
-	// "private-my_orders",
-	// "private-my_trades",
+	"private-my_orders",
+	"private-my_trades",
 }
 
 func main() {
@@ -1,40 +1,118 @@
 package bitstamp
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
 
 var (
-	ErrAlreadySubscribed        = errors.New("you can subscribe once per client instance")
-	ErrUnableToParseMessage     = errors.New("failed to parse websocket message")
-	ErrReceivedReconnectMessage = errors.New("Bitstamp requested to reconnect")
-	ErrReadMessage              = errors.New("failed to read message")
-	ErrWriteMessage             = errors.New("failed to write message")
+	ErrAlreadySubscribed         = errors.New("you can consume once per client instance")
+	ErrUnableToParseMessage      = errors.New("failed to parse websocket message")
+	ErrReceivedReconnectMessage  = errors.New("Bitstamp requested to reconnect")
+	ErrReadMessage               = errors.New("failed to read message")
+	ErrWriteMessage              = errors.New("failed to write message")
+	ErrReconnectFailed           = errors.New("failed to reconnect")
+	ErrConnectionClosed          = errors.New("connection is closed")
+	ErrStaleConnection           = errors.New("no message received within stale timeout")
+	ErrPrivateChannelsNotEnabled = errors.New("private channels require PrivateChannelsOption")
+	ErrNotConnected              = errors.New("connection is not established, call Connect")
 )
 
+const privateChannelPrefix = "private-"
+
 type WebsocketMessage struct {
 	Message    interface{}
 	RawMessage []byte
 	Error      error
 }
 
+// WebsocketReconnect message emitted on the message channel after a successful reconnection and resubscription
+type WebsocketReconnect struct {
+	// Attempts number of dial attempts needed to reconnect
+	Attempts int
+	// Reason error that caused the reconnection
+	Reason error
+}
+
+// websocketToken cached token used to subscribe to private channels
+type websocketToken struct {
+	value     string
+	userID    string
+	refreshAt time.Time
+}
+
+type reconnectConfig struct {
+	enabled     bool
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+}
+
+// writeRequest a message queued for the writer goroutine
+type writeRequest struct {
+	message  []byte
+	deadline time.Time
+	result   chan error
+}
+
 type WebsocketAPI struct {
-	conn        *websocket.Conn
-	address     string
-	channelSubs sync.Map
+	counters  messageCounters
+	mu        sync.RWMutex
+	conn      *websocket.Conn
+	closed    bool
+	consuming bool
+	// writes are serialized through a single writer goroutine, done is closed when client is closed
+	writes  chan writeRequest
+	done    chan struct{}
+	address string
+	subs    *subscriptions
+	// waitAck makes subscribe and unsubscribe requests wait for server confirmation
+	waitAck   bool
+	reconnect reconnectConfig
+	// heartbeat interval of bts:heartbeat messages and ping frames, zero disables heartbeat
+	heartbeat time.Duration
+	// staleTimeout max duration without any message or pong before connection is considered stale, zero disables it
+	staleTimeout time.Duration
+	// tokenAPI is used to retrieve tokens for private channels
+	tokenAPI *HTTPAPI
+	tokenMu  sync.Mutex
+	token    websocketToken
+	// handlers registered using On* methods
+	handlersMu  sync.RWMutex
+	handlers    []messageHandler
+	rawHandlers []func(WebsocketMessage)
+	// buffer size and overflow policy of consumed messages
+	buffer bufferConfig
+	// dialer and header are used to establish connections, deferConnect skips dialing in NewWebsocketAPI
+	dialer           *websocket.Dialer
+	header           http.Header
+	compression      bool
+	handshakeTimeout time.Duration
+	deferConnect     bool
 }
 
 func NewWebsocketAPI(opts ...wsOption) (*WebsocketAPI, error) {
 	w := WebsocketAPI{
-		address:     "wss://ws.bitstamp.net",
-		channelSubs: sync.Map{},
+		address: "wss://ws.bitstamp.net",
+		subs:    newSubscriptions(),
+		writes:  make(chan writeRequest),
+		done:    make(chan struct{}),
+		reconnect: reconnectConfig{
+			minBackoff: time.Second,
+			maxBackoff: time.Minute,
+		},
 	}
 
 	// override defaults via available functional options
@@ -42,155 +120,595 @@ func NewWebsocketAPI(opts ...wsOption) (*WebsocketAPI, error) {
 		opts[i](&w)
 	}
 
-	c, _, err := websocket.DefaultDialer.Dial(w.address, nil)
+	// copy dialer so that options do not modify a shared dialer
+	dialer := *websocket.DefaultDialer
+	if w.dialer != nil {
+		dialer = *w.dialer
+	}
+	if w.compression {
+		dialer.EnableCompression = true
+	}
+	if w.handshakeTimeout > 0 {
+		dialer.HandshakeTimeout = w.handshakeTimeout
+	}
+	w.dialer = &dialer
+
+	if !w.deferConnect {
+		if err := w.Connect(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	go w.writer()
+
+	return &w, nil
+}
+
+// Connect dials the websocket address, it is called by NewWebsocketAPI unless DeferConnectOption is used. Calling
+// it on a connected client does nothing.
+func (w *WebsocketAPI) Connect(ctx context.Context) error {
+	if w.connection() != nil {
+		return nil
+	}
+
+	c, err := w.dial(ctx)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch {
+	case w.closed:
+		_ = c.Close()
+		return ErrConnectionClosed
+	case w.conn != nil:
+		// connected concurrently
+		_ = c.Close()
+		return nil
 	}
 	w.conn = c
 
-	return &w, nil
+	return nil
 }
 
-// Consume subscribe to channel(s) and start consuming messages
-// do not call this on same instance twice
+// Consume subscribe to channel(s) and start consuming messages, it can be called once per instance and
+// ErrAlreadySubscribed is returned on subsequent calls unless the previous call failed. More channels can be added
+// while consuming using SubscribeToChannels from any goroutine.
+//
+// When reconnection is enabled (see AutoReconnectOption) read errors and reconnect requests do not close the
+// stream, the client redials using exponential backoff with jitter, resubscribes to all tracked channels and
+// emits a WebsocketReconnect message.
+//
+// When heartbeat is enabled (see HeartbeatOption) bts:heartbeat messages are sent periodically and their replies
+// are delivered as WebSocketMessage. A connection that stays silent longer than the stale timeout
+// (see StaleTimeoutOption) fails with ErrStaleConnection.
+//
+// Messages are delivered unbuffered by default so a slow consumer stalls reading from the connection, use
+// BufferOption to buffer messages and drop or coalesce them when the consumer falls behind.
+//
+// When the client is created using DeferConnectOption Consume connects if Connect was not called.
+//
+// Consume does not wait for subscriptions to be confirmed, their state can be checked using GetSubscriptions.
 func (w *WebsocketAPI) Consume(ctx context.Context, channels ...Channel) (<-chan WebsocketMessage, error) {
+	w.mu.Lock()
+	if w.consuming {
+		w.mu.Unlock()
+		return nil, ErrAlreadySubscribed
+	}
+	w.consuming = true
+	w.mu.Unlock()
+
+	// subscribe before starting to read so that a failure leaves the client ready for another Consume call
+	err := w.Connect(ctx)
+	if err == nil {
+		err = w.subscribe(ctx, false, channels...)
+	}
+	if err != nil {
+		w.mu.Lock()
+		w.consuming = false
+		w.mu.Unlock()
+
+		return nil, err
+	}
+
 	messages := make(chan WebsocketMessage)
-	wsMessages := make(chan []byte)
+	wsMessages := make(chan WebsocketMessage)
+	done := make(chan struct{})
 
+	// unblock pending reads when ctx is cancelled
 	go func() {
-		for {
-			msg, err := w.readMessage(ctx)
-			if err != nil {
-				messages <- WebsocketMessage{Error: err}
-				close(wsMessages)
-				return
-			}
-
-			wsMessages <- msg
+		select {
+		case <-ctx.Done():
+			_ = w.connection().SetReadDeadline(time.Now())
+		case <-done:
 		}
 	}()
 
+	if w.heartbeat > 0 {
+		go w.sendHeartbeats(ctx, done)
+	}
+
 	go func() {
-		defer close(messages)
+		defer close(wsMessages)
+		defer close(done)
 
-		for {
+		send := func(m WebsocketMessage) bool {
 			select {
+			case wsMessages <- m:
+				return true
 			case <-ctx.Done():
-				return
+				return false
+			}
+		}
 
-			case m, ok := <-wsMessages:
-				if !ok {
+		for {
+			msg, err := w.readMessage(ctx)
+			if err == nil && !(w.reconnect.enabled && isReconnectRequest(msg)) {
+				if !send(WebsocketMessage{RawMessage: msg}) {
 					return
 				}
+				continue
+			}
 
-				msg, err := parseMessage(m)
-				if err != nil {
-					messages <- WebsocketMessage{
-						Error:      fmt.Errorf("unable to parse message, %w", err),
-						RawMessage: m,
-					}
+			if err == nil {
+				err = ErrReceivedReconnectMessage
+			}
 
-					continue
-				}
+			if !w.reconnect.enabled || w.isClosed() || ctx.Err() != nil {
+				send(WebsocketMessage{Error: err})
+				return
+			}
 
-				messages <- WebsocketMessage{
-					Message:    msg,
-					RawMessage: m,
-				}
+			attempts, rerr := w.redial(ctx)
+			if rerr != nil {
+				send(WebsocketMessage{Error: fmt.Errorf("%w after %d attempts, %s", ErrReconnectFailed, attempts, rerr)})
+				return
+			}
+
+			if !send(WebsocketMessage{Message: WebsocketReconnect{Attempts: attempts, Reason: err}}) {
+				return
 			}
 		}
 	}()
 
-	if err := w.SubscribeToChannels(ctx, channels...); err != nil {
-		return nil, err
-	}
+	go pump(ctx, wsMessages, messages, w.buffer, &w.counters, func(m *WebsocketMessage) {
+		if m.Error == nil && m.Message == nil {
+			msg, err := parseMessage(m.RawMessage)
+			if err != nil {
+				m.Error = fmt.Errorf("unable to parse message, %w", err)
+			}
+			m.Message = msg
+		}
+
+		if v, ok := m.Message.(WebSocketMessage); ok {
+			w.subs.handle(v.Event, v.Channel, errorMessage(v))
+		}
+	})
 
 	return messages, nil
 }
 
-// SubscribeToChannels use this method to subscribe to channel(s)
+// SubscribeToChannels use this method to subscribe to channel(s), subscribing to private channels requires
+// PrivateChannelsOption. When SubscriptionAckOption is used it blocks until the server confirms each subscription
+// or ctx is done, a rejected subscription returns a SubscriptionError. Confirmations are read by Consume so do not
+// call it from the goroutine that reads messages.
 func (w *WebsocketAPI) SubscribeToChannels(ctx context.Context, channels ...Channel) error {
+	return w.subscribe(ctx, w.waitAck, channels...)
+}
+
+// UnSubscribeFromChannels use this method to unsubscribe from channel(s), when SubscriptionAckOption is used it
+// blocks until the server confirms each request or ctx is done
+func (w *WebsocketAPI) UnSubscribeFromChannels(ctx context.Context, channels ...Channel) error {
 	for i := range channels {
-		m := fmt.Sprintf(`{"event":"bts:subscribe","data":{"channel": "%s"}}`, channels[i].String())
+		name, m, err := w.channelMessage(ctx, "bts:unsubscribe", channels[i])
+		if err != nil {
+			return fmt.Errorf("failed to unsubscribe from channel %s, %w", channels[i].String(), err)
+		}
 
-		if err := w.writeMessage(ctx, []byte(m)); err != nil {
-			return fmt.Errorf("failed to subscribe to channel %s, %w", channels[i].String(), err)
+		ack := w.subs.remove(channels[i], name, w.waitAck)
+
+		if err := w.writeMessage(ctx, m); err != nil {
+			w.subs.discard(channels[i], name, true, ack)
+			return fmt.Errorf("failed to unsubscribe from channel %s, %w", channels[i].String(), err)
 		}
 
-		w.channelSubs.Store(channels[i], struct{}{})
+		if err := w.waitForAck(ctx, ack); err != nil {
+			return fmt.Errorf("failed to unsubscribe from channel %s, %w", channels[i].String(), err)
+		}
 	}
 
 	return nil
 }
 
-// UnSubscribeFromChannels use this method to unsubscribe from channel(s)
-func (w *WebsocketAPI) UnSubscribeFromChannels(ctx context.Context, channels ...Channel) error {
+// UnSubscribeFromAllChannels use this method to unsubscribe from all channels you are subscribed
+func (w *WebsocketAPI) UnSubscribeFromAllChannels(ctx context.Context) error {
+	subs := w.GetSubscriptions()
+
+	channels := make([]Channel, 0, len(subs))
+	for i := range subs {
+		channels = append(channels, subs[i].Channel)
+	}
+
+	return w.UnSubscribeFromChannels(ctx, channels...)
+}
+
+// DroppedMessages returns the number of messages discarded by the overflow policy (see BufferOption)
+func (w *WebsocketAPI) DroppedMessages() uint64 {
+	return atomic.LoadUint64(&w.counters.dropped)
+}
+
+// CoalescedMessages returns the number of order book snapshots replaced by newer ones (see OverflowCoalesce)
+func (w *WebsocketAPI) CoalescedMessages() uint64 {
+	return atomic.LoadUint64(&w.counters.coalesced)
+}
+
+// GetSubscriptions returns a list of tracked subscriptions and their state
+func (w *WebsocketAPI) GetSubscriptions() []Subscription {
+	return w.subs.list()
+}
+
+func (w *WebsocketAPI) subscribe(ctx context.Context, wait bool, channels ...Channel) error {
 	for i := range channels {
-		m := fmt.Sprintf(`{"event":"bts:unsubscribe","data":{"channel": "%s"}}`, channels[i].String())
+		name, m, err := w.channelMessage(ctx, "bts:subscribe", channels[i])
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to channel %s, %w", channels[i].String(), err)
+		}
 
-		if err := w.conn.WriteMessage(websocket.TextMessage, []byte(m)); err != nil {
-			return fmt.Errorf("failed to unsubscribe from channel %s, %w", channels[i].String(), err)
+		ack := w.subs.add(channels[i], name, wait)
+
+		if err := w.writeMessage(ctx, m); err != nil {
+			w.subs.discard(channels[i], name, false, ack)
+			return fmt.Errorf("failed to subscribe to channel %s, %w", channels[i].String(), err)
 		}
 
-		w.channelSubs.Delete(channels[i])
+		if err := w.waitForAck(ctx, ack); err != nil {
+			return fmt.Errorf("failed to subscribe to channel %s, %w", channels[i].String(), err)
+		}
 	}
 
 	return nil
 }
 
-// UnSubscribeFromAllChannels use this method to unsubscribe from all channels you are subscribed
-func (w *WebsocketAPI) UnSubscribeFromAllChannels(ctx context.Context) error {
-	channels := w.GetSubscriptions()
+// resubscribe sends subscribe requests for all tracked channels that have not failed, used after reconnecting
+func (w *WebsocketAPI) resubscribe(ctx context.Context) error {
+	subs := w.GetSubscriptions()
+	w.subs.reset()
 
-	for i := range channels {
-		m := fmt.Sprintf(`{"event":"bts:unsubscribe","data":{"channel": "%s"}}`, channels[i].String())
+	for i := range subs {
+		if subs[i].State == SubscriptionFailed {
+			continue
+		}
 
-		if err := w.conn.WriteMessage(websocket.TextMessage, []byte(m)); err != nil {
-			return fmt.Errorf("failed to unsubscribe from channel %s, %w", channels[i].String(), err)
+		name, m, err := w.channelMessage(ctx, "bts:subscribe", subs[i].Channel)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to channel %s, %w", subs[i].Channel.String(), err)
 		}
 
-		w.channelSubs.Delete(channels[i])
+		w.subs.add(subs[i].Channel, name, false)
+
+		if err := w.writeMessage(ctx, m); err != nil {
+			return fmt.Errorf("failed to subscribe to channel %s, %w", subs[i].Channel.String(), err)
+		}
 	}
 
 	return nil
 }
 
-// GetSubscriptions returns a list of tracked subscriptions
-func (w *WebsocketAPI) GetSubscriptions() []Channel {
-	var channels []Channel
+func (w *WebsocketAPI) waitForAck(ctx context.Context, ack <-chan error) error {
+	if ack == nil {
+		return nil
+	}
 
-	w.channelSubs.Range(func(key, _ interface{}) bool {
-		k, _ := key.(Channel)
-		channels = append(channels, k)
+	select {
+	case err := <-ack:
+		return err
+	case <-ctx.Done():
+		w.subs.cancel(ack)
+		return ctx.Err()
+	}
+}
 
-		return true
-	})
+// channelMessage creates a subscribe or unsubscribe message and returns it together with the channel name that is
+// sent, private channel names are suffixed with the user id and subscriptions are authenticated using a token
+func (w *WebsocketAPI) channelMessage(ctx context.Context, event string, c Channel) (string, []byte, error) {
+	var data struct {
+		Channel string `json:"channel"`
+		Auth    string `json:"auth,omitempty"`
+	}
+	data.Channel = c.String()
 
-	return channels
+	if c.IsPrivate() {
+		t, err := w.getToken(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+
+		data.Channel += "-" + t.userID
+		if event == "bts:subscribe" {
+			data.Auth = t.value
+		}
+	}
+
+	m, err := json.Marshal(WebSocketMessage{Event: event, Data: data})
+
+	return data.Channel, m, err
+}
+
+// getToken returns the cached websockets token, a new token is retrieved when the cached one is close to expire
+func (w *WebsocketAPI) getToken(ctx context.Context) (websocketToken, error) {
+	if w.tokenAPI == nil {
+		return websocketToken{}, ErrPrivateChannelsNotEnabled
+	}
+
+	w.tokenMu.Lock()
+	defer w.tokenMu.Unlock()
+
+	if w.token.value != "" && time.Now().Before(w.token.refreshAt) {
+		return w.token, nil
+	}
+
+	resp, err := w.tokenAPI.GetWebsocketsToken(ctx)
+	if err != nil {
+		return websocketToken{}, fmt.Errorf("failed to retrieve websockets token, %w", err)
+	}
+
+	valid, err := resp.ValidSeconds.Int64()
+	if err != nil {
+		return websocketToken{}, fmt.Errorf("failed to parse websockets token validity `%s`, %w", resp.ValidSeconds, err)
+	}
+
+	// refresh token when 80% of its validity has passed
+	w.token = websocketToken{
+		value:     resp.Token,
+		userID:    resp.UserID.String(),
+		refreshAt: time.Now().Add(time.Duration(valid) * time.Second * 4 / 5),
+	}
+
+	return w.token, nil
 }
 
 func (w *WebsocketAPI) readMessage(ctx context.Context) ([]byte, error) {
-	_, msg, err := w.conn.ReadMessage()
+	c := w.connection()
+
+	if w.staleTimeout > 0 {
+		_ = c.SetReadDeadline(time.Now().Add(w.staleTimeout))
+	}
+
+	// deadline must be set before checking ctx, otherwise a cancellation could be overridden
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrReadMessage, err)
+	}
+
+	_, msg, err := c.ReadMessage()
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("%w, %s", ErrReadMessage, ctx.Err())
+		}
+
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil, fmt.Errorf("%w, %s", ErrStaleConnection, err)
+		}
+
 		return nil, fmt.Errorf("%w, %s", ErrReadMessage, err)
 	}
 
 	return msg, nil
 }
 
+// sendHeartbeats sends a bts:heartbeat message and a ping frame every heartbeat interval until ctx is cancelled
+// or done is closed. Write errors are ignored, a broken connection is detected by the reader.
+func (w *WebsocketAPI) sendHeartbeats(ctx context.Context, done <-chan struct{}) {
+	ticker := time.NewTicker(w.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case <-ticker.C:
+			_ = w.writeMessage(ctx, []byte(`{"event":"bts:heartbeat"}`))
+			_ = w.connection().WriteControl(websocket.PingMessage, nil, time.Now().Add(w.heartbeat))
+		}
+	}
+}
+
+// writeMessage queues a message for the writer goroutine and waits until it is written, ctx deadline is used as
+// write deadline
 func (w *WebsocketAPI) writeMessage(ctx context.Context, m []byte) error {
-	if err := w.conn.WriteMessage(websocket.TextMessage, []byte(m)); err != nil {
-		return fmt.Errorf("%w, %s", ErrWriteMessage, err)
+	req := writeRequest{message: m, result: make(chan error, 1)}
+	req.deadline, _ = ctx.Deadline()
+
+	select {
+	case w.writes <- req:
+	case <-ctx.Done():
+		return fmt.Errorf("%w, %s", ErrWriteMessage, ctx.Err())
+	case <-w.done:
+		return fmt.Errorf("%w, %s", ErrWriteMessage, ErrConnectionClosed)
 	}
 
-	return nil
+	select {
+	case err := <-req.result:
+		return err
+	case <-w.done:
+		return fmt.Errorf("%w, %s", ErrWriteMessage, ErrConnectionClosed)
+	}
+}
+
+// writer is the only goroutine that writes data messages to the connection since gorilla websocket does not
+// support concurrent writers, it runs until client is closed
+func (w *WebsocketAPI) writer() {
+	for {
+		select {
+		case <-w.done:
+			return
+		case req := <-w.writes:
+			c := w.connection()
+			if c == nil {
+				req.result <- fmt.Errorf("%w, %s", ErrWriteMessage, ErrNotConnected)
+				continue
+			}
+
+			err := c.SetWriteDeadline(req.deadline)
+			if err == nil {
+				err = c.WriteMessage(websocket.TextMessage, req.message)
+			}
+			if err != nil {
+				err = fmt.Errorf("%w, %s", ErrWriteMessage, err)
+			}
+
+			req.result <- err
+		}
+	}
+}
+
+func (w *WebsocketAPI) connection() *websocket.Conn {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.conn
+}
+
+func (w *WebsocketAPI) isClosed() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.closed
+}
+
+func (w *WebsocketAPI) dial(ctx context.Context) (*websocket.Conn, error) {
+	c, _, err := w.dialer.DialContext(ctx, w.address, w.header)
+	if err != nil {
+		return nil, err
+	}
+
+	// pong frames prove that the connection is alive even if there are no messages
+	if w.staleTimeout > 0 {
+		c.SetPongHandler(func(string) error {
+			return c.SetReadDeadline(time.Now().Add(w.staleTimeout))
+		})
+	}
+
+	return c, nil
+}
+
+// redial replaces the current connection using exponential backoff with jitter and resubscribes to all tracked
+// channels, returns the number of attempts made
+func (w *WebsocketAPI) redial(ctx context.Context) (int, error) {
+	_ = w.connection().Close()
+
+	var err error
+	for attempt := 1; w.reconnect.maxAttempts == 0 || attempt <= w.reconnect.maxAttempts; attempt++ {
+		timer := time.NewTimer(w.reconnect.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt - 1, ctx.Err()
+		case <-timer.C:
+		}
+
+		var c *websocket.Conn
+		if c, err = w.dial(ctx); err != nil {
+			continue
+		}
+
+		w.mu.Lock()
+		if w.closed {
+			w.mu.Unlock()
+			_ = c.Close()
+			return attempt, ErrConnectionClosed
+		}
+		w.conn = c
+		w.mu.Unlock()
+
+		if err = w.resubscribe(ctx); err != nil {
+			_ = c.Close()
+			continue
+		}
+
+		return attempt, nil
+	}
+
+	return w.reconnect.maxAttempts, err
+}
+
+// backoff returns the delay before a reconnection attempt, delay doubles on every attempt up to max backoff and
+// a random jitter of up to half the delay is subtracted so that clients do not reconnect at the same time
+func (r reconnectConfig) backoff(attempt int) time.Duration {
+	d := r.minBackoff
+	for i := 1; i < attempt && d < r.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.maxBackoff {
+		d = r.maxBackoff
+	}
+
+	if half := int64(d / 2); half > 0 {
+		d -= time.Duration(rand.Int63n(half + 1))
+	}
+
+	return d
 }
 
 // Close connection
 func (w *WebsocketAPI) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	close(w.done)
+
+	if w.conn == nil {
+		return nil
+	}
+
 	return w.conn.Close()
 }
 
+func isOrderEvent(event string) bool {
+	switch OrderEvent(event) {
+	case OrderEventCreated, OrderEventChanged, OrderEventDeleted:
+		return true
+	}
+
+	return false
+}
+
+// errorMessage extracts the message of a bts:error event
+func errorMessage(m WebSocketMessage) string {
+	if m.Event != "bts:error" {
+		return ""
+	}
+
+	if data, ok := m.Data.(map[string]interface{}); ok {
+		if msg, ok := data["message"].(string); ok {
+			return msg
+		}
+	}
+
+	return fmt.Sprint(m.Data)
+}
+
+func isReconnectRequest(m []byte) bool {
+	if !bytes.Contains(m, []byte("bts:request_reconnect")) {
+		return false
+	}
+
+	var msg WebSocketMessage
+	if err := json.Unmarshal(m, &msg); err != nil {
+		return false
+	}
+
+	return msg.Event == "bts:request_reconnect"
+}
+
 func parseMessage(m []byte) (interface{}, error) {
 	if m == nil {
 		return nil, nil
@@ -215,13 +733,28 @@ func parseMessage(m []byte) (interface{}, error) {
 		msg = channelMSG
 
 	case strings.HasPrefix(wsMsg.Channel, "live_orders_") &&
-		(wsMsg.Event == "order_created" || wsMsg.Event == "order_changed " || wsMsg.Event == "order_deleted"):
+		isOrderEvent(wsMsg.Event):
 		var channelMSG LiveOrdersChannel
 		if err := json.Unmarshal(m, &channelMSG); err != nil {
 			return nil, fmt.Errorf("%w, %s", ErrUnableToParseMessage, err)
 		}
 		msg = channelMSG
 
+	case strings.HasPrefix(wsMsg.Channel, "private-my_orders_") &&
+		isOrderEvent(wsMsg.Event):
+		var channelMSG LiveMyOrdersChannel
+		if err := json.Unmarshal(m, &channelMSG); err != nil {
+			return nil, fmt.Errorf("%w, %s", ErrUnableToParseMessage, err)
+		}
+		msg = channelMSG
+
+	case strings.HasPrefix(wsMsg.Channel, "private-my_trades_") && wsMsg.Event == "trade":
+		var channelMSG LiveMyTradesChannel
+		if err := json.Unmarshal(m, &channelMSG); err != nil {
+			return nil, fmt.Errorf("%w, %s", ErrUnableToParseMessage, err)
+		}
+		msg = channelMSG
+
 	case strings.HasPrefix(wsMsg.Channel, "order_book_") && wsMsg.Event == "data":
 		var lobc LiveOrderBookChannel
 		if err := json.Unmarshal(m, &lobc); err != nil {
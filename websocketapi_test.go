@@ -0,0 +1,996 @@
+package bitstamp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/georlav/bitstamp"
+	"github.com/georlav/httprawmock"
+	"github.com/gorilla/websocket"
+)
+
+const testTradeMessage = `{"data":{"id":1,"timestamp":"1637511612","amount":0.1,"amount_str":"0.10000000","price":52261.99,` +
+	`"price_str":"52261.99","type":0,"microtimestamp":"1637511612084301","buy_order_id":1,"sell_order_id":2},` +
+	`"channel":"live_trades_btcusd","event":"trade"}`
+
+// newWebsocketServer starts a websocket server, handler is called for every connection with its sequence number
+func newWebsocketServer(t *testing.T, handler func(n int, c *websocket.Conn)) string {
+	t.Helper()
+
+	var (
+		upgrader    websocket.Upgrader
+		connections int32
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection, %s", err)
+			return
+		}
+		defer c.Close()
+
+		handler(int(atomic.AddInt32(&connections, 1)), c)
+	}))
+	t.Cleanup(ts.Close)
+
+	return "ws" + strings.TrimPrefix(ts.URL, "http")
+}
+
+func expectSubscribe(t *testing.T, c *websocket.Conn, channel string) bool {
+	t.Helper()
+
+	_, msg, err := c.ReadMessage()
+	if err != nil {
+		return false
+	}
+
+	if !strings.Contains(string(msg), "bts:subscribe") || !strings.Contains(string(msg), channel) {
+		t.Errorf("Expected subscription to %s got %s", channel, msg)
+		return false
+	}
+
+	return true
+}
+
+func nextMessage(t *testing.T, messages <-chan bitstamp.WebsocketMessage) bitstamp.WebsocketMessage {
+	t.Helper()
+
+	select {
+	case m, ok := <-messages:
+		if !ok {
+			t.Fatal("message channel closed unexpectedly")
+		}
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+
+	return bitstamp.WebsocketMessage{}
+}
+
+func TestWebsocketAPI_Consume_Reconnect(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		if !expectSubscribe(t, c, "live_trades_btcusd") {
+			return
+		}
+
+		switch n {
+		case 1:
+			_ = c.WriteMessage(websocket.TextMessage, []byte(`{"event":"bts:request_reconnect","channel":"","data":""}`))
+			_, _, _ = c.ReadMessage()
+		case 2:
+			// drop connection without closing handshake
+			_ = c.UnderlyingConn().Close()
+		default:
+			_ = c.WriteMessage(websocket.TextMessage, []byte(testTradeMessage))
+			_, _, _ = c.ReadMessage()
+		}
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(
+		bitstamp.SetWSAddressOption(address),
+		bitstamp.AutoReconnectOption(),
+		bitstamp.ReconnectBackoffOption(time.Millisecond, 10*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := ws.Consume(ctx, bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	m := nextMessage(t, messages)
+	if r, ok := m.Message.(bitstamp.WebsocketReconnect); !ok || !errors.Is(r.Reason, bitstamp.ErrReceivedReconnectMessage) {
+		t.Fatalf("Expected reconnect message caused by reconnect request got %+v", m)
+	}
+
+	m = nextMessage(t, messages)
+	if r, ok := m.Message.(bitstamp.WebsocketReconnect); !ok || !errors.Is(r.Reason, bitstamp.ErrReadMessage) {
+		t.Fatalf("Expected reconnect message caused by read error got %+v", m)
+	}
+
+	m = nextMessage(t, messages)
+	if trade, ok := m.Message.(bitstamp.LiveTickerChannel); !ok || trade.Data.Price.String() != "52261.99" {
+		t.Fatalf("Expected trade message after resubscription got %+v", m)
+	}
+}
+
+func TestWebsocketAPI_Consume_ReconnectFailed(t *testing.T) {
+	var (
+		upgrader    websocket.Upgrader
+		connections int32
+	)
+
+	// only the first connection is accepted so all reconnection attempts fail
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&connections, 1) > 1 {
+			http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection, %s", err)
+			return
+		}
+		defer c.Close()
+
+		if expectSubscribe(t, c, "live_trades_btcusd") {
+			_ = c.UnderlyingConn().Close()
+		}
+	}))
+	defer t.Cleanup(ts.Close)
+
+	ws, err := bitstamp.NewWebsocketAPI(
+		bitstamp.SetWSAddressOption("ws"+strings.TrimPrefix(ts.URL, "http")),
+		bitstamp.AutoReconnectOption(),
+		bitstamp.ReconnectBackoffOption(time.Millisecond, time.Millisecond),
+		bitstamp.ReconnectMaxAttemptsOption(3),
+	)
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	messages, err := ws.Consume(context.Background(), bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	if m := nextMessage(t, messages); !errors.Is(m.Error, bitstamp.ErrReconnectFailed) {
+		t.Fatalf("Expected error %s got %+v", bitstamp.ErrReconnectFailed, m)
+	}
+
+	if _, ok := <-messages; ok {
+		t.Fatal("Expected message channel to be closed")
+	}
+
+	if n := atomic.LoadInt32(&connections); n != 4 {
+		t.Fatalf("Expected 1 connection and 3 reconnection attempts got %d", n)
+	}
+}
+
+func TestWebsocketAPI_Consume_WithoutReconnect(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		if expectSubscribe(t, c, "live_trades_btcusd") {
+			_ = c.WriteMessage(websocket.TextMessage, []byte(`{"event":"bts:request_reconnect","channel":"","data":""}`))
+			_ = c.UnderlyingConn().Close()
+		}
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(bitstamp.SetWSAddressOption(address))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	messages, err := ws.Consume(context.Background(), bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	if m := nextMessage(t, messages); !errors.Is(m.Error, bitstamp.ErrReceivedReconnectMessage) {
+		t.Fatalf("Expected error %s got %+v", bitstamp.ErrReceivedReconnectMessage, m)
+	}
+
+	if m := nextMessage(t, messages); !errors.Is(m.Error, bitstamp.ErrReadMessage) {
+		t.Fatalf("Expected error %s got %+v", bitstamp.ErrReadMessage, m)
+	}
+
+	if _, ok := <-messages; ok {
+		t.Fatal("Expected message channel to be closed")
+	}
+}
+
+func TestWebsocketAPI_Consume_StaleConnection(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		// stay silent, ping frames are not answered since server never reads
+		expectSubscribe(t, c, "live_trades_btcusd")
+		time.Sleep(time.Second)
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(
+		bitstamp.SetWSAddressOption(address),
+		bitstamp.HeartbeatOption(20*time.Millisecond),
+		bitstamp.StaleTimeoutOption(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	messages, err := ws.Consume(context.Background(), bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	if m := nextMessage(t, messages); !errors.Is(m.Error, bitstamp.ErrStaleConnection) {
+		t.Fatalf("Expected error %s got %+v", bitstamp.ErrStaleConnection, m)
+	}
+
+	if _, ok := <-messages; ok {
+		t.Fatal("Expected message channel to be closed")
+	}
+}
+
+func TestWebsocketAPI_Consume_Heartbeat(t *testing.T) {
+	var heartbeats int32
+
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		if !expectSubscribe(t, c, "live_trades_btcusd") {
+			return
+		}
+
+		// reading answers ping frames with pongs, heartbeat messages are not answered
+		for {
+			_, msg, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			if strings.Contains(string(msg), "bts:heartbeat") {
+				atomic.AddInt32(&heartbeats, 1)
+			}
+		}
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(
+		bitstamp.SetWSAddressOption(address),
+		bitstamp.HeartbeatOption(20*time.Millisecond),
+		bitstamp.StaleTimeoutOption(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := ws.Consume(ctx, bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	select {
+	case m := <-messages:
+		t.Fatalf("Expected pongs to keep connection alive got %+v", m)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	if n := atomic.LoadInt32(&heartbeats); n < 5 {
+		t.Fatalf("Expected at least 5 heartbeats got %d", n)
+	}
+
+	// cancelling ctx must unblock pending read and close the stream
+	cancel()
+	select {
+	case _, ok := <-messages:
+		if ok {
+			t.Fatal("Expected message channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message channel to close")
+	}
+}
+
+func TestWebsocketAPI_Consume_PrivateChannels(t *testing.T) {
+	testCases := []struct {
+		description           string
+		responseFile          string
+		expectedTokenRequests int32
+	}{
+		{
+			description:           "Should reuse token for all subscriptions",
+			responseFile:          "testdata/get_websockets_token_200.txt",
+			expectedTokenRequests: 1,
+		},
+		{
+			description:           "Should refresh expired token on every subscription",
+			responseFile:          "testdata/get_websockets_token_expired_200.txt",
+			expectedTokenRequests: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := os.ReadFile(tc.responseFile)
+			if err != nil {
+				t.Fatalf("failed to parse response file `%s`, %s", tc.responseFile, err)
+			}
+
+			var tokenRequests int32
+			ts := httprawmock.NewUnstartedServer(
+				httprawmock.NewRoute(http.MethodPost, "/api/v2/websockets_token/", b),
+			)
+			next := ts.Config.Handler
+			ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&tokenRequests, 1)
+				next.ServeHTTP(w, r)
+			})
+			ts.Start()
+			defer t.Cleanup(ts.Close)
+
+			address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+				for _, channel := range []string{"private-my_orders_btcusd-1234", "private-my_trades_btcusd-1234"} {
+					var sub struct {
+						Event string `json:"event"`
+						Data  struct {
+							Channel string `json:"channel"`
+							Auth    string `json:"auth"`
+						} `json:"data"`
+					}
+					if err := c.ReadJSON(&sub); err != nil {
+						t.Errorf("failed to read subscription, %s", err)
+						return
+					}
+
+					if sub.Event != "bts:subscribe" || sub.Data.Channel != channel || sub.Data.Auth != "xD4eW5s0Gk9z1qLm7Tn2Rb8Yc3Vf6Hj" {
+						t.Errorf("Unexpected subscription %+v", sub)
+					}
+				}
+
+				_ = c.WriteMessage(websocket.TextMessage, []byte(`{"data":{"id":1432101229445121,"id_str":"1432101229445121",`+
+					`"client_order_id":"my-order-1","order_type":0,"datetime":"1637511612","microtimestamp":"1637511612084301",`+
+					`"amount":0.1,"amount_str":"0.10000000","price":52261.99,"price_str":"52261.99"},`+
+					`"channel":"private-my_orders_btcusd-1234","event":"order_created"}`))
+				_ = c.WriteMessage(websocket.TextMessage, []byte(`{"data":{"id":216389233,"order_id":1432101229445121,`+
+					`"client_order_id":"my-order-1","amount":"0.10000000","price":"52261.99","fee":"0.02613",`+
+					`"side":"buy","microtimestamp":"1637511612184301"},"channel":"private-my_trades_btcusd-1234","event":"trade"}`))
+				_, _, _ = c.ReadMessage()
+			})
+
+			ws, err := bitstamp.NewWebsocketAPI(
+				bitstamp.SetWSAddressOption(address),
+				bitstamp.PrivateChannelsOption(bitstamp.NewHTTPAPI(
+					bitstamp.BaseURLOption(ts.URL),
+					bitstamp.APIKeyOption("key"),
+					bitstamp.APISecretOption("secret"),
+				)),
+			)
+			if err != nil {
+				t.Fatalf("failed to initialize websocket client, %s", err)
+			}
+			defer ws.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			messages, err := ws.Consume(ctx,
+				bitstamp.GetMyOrdersChannel(bitstamp.BTCUSD),
+				bitstamp.GetMyTradesChannel(bitstamp.BTCUSD),
+			)
+			if err != nil {
+				t.Fatalf("failed to consume, %s", err)
+			}
+
+			m := nextMessage(t, messages)
+			order, ok := m.Message.(bitstamp.LiveMyOrdersChannel)
+			if !ok || order.Data.ClientOrderID != "my-order-1" || order.Data.Price.String() != "52261.99" {
+				t.Fatalf("Expected my order message got %+v", m)
+			}
+
+			m = nextMessage(t, messages)
+			trade, ok := m.Message.(bitstamp.LiveMyTradesChannel)
+			if !ok || trade.Data.OrderID != order.Data.ID || trade.Data.Fee.String() != "0.02613" {
+				t.Fatalf("Expected my trade message got %+v", m)
+			}
+
+			if n := atomic.LoadInt32(&tokenRequests); n != tc.expectedTokenRequests {
+				t.Fatalf("Expected %d token requests got %d", tc.expectedTokenRequests, n)
+			}
+		})
+	}
+}
+
+func TestWebsocketAPI_SubscribeToChannels_PrivateNotEnabled(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		_, _, _ = c.ReadMessage()
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(bitstamp.SetWSAddressOption(address))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	err = ws.SubscribeToChannels(context.Background(), bitstamp.MyOrdersBTCUSDPrivateChannel)
+	if !errors.Is(err, bitstamp.ErrPrivateChannelsNotEnabled) {
+		t.Fatalf("Expected error %s got %v", bitstamp.ErrPrivateChannelsNotEnabled, err)
+	}
+}
+
+func TestWebsocketAPI_SubscribeToChannels_Ack(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		for {
+			var req struct {
+				Event string `json:"event"`
+				Data  struct {
+					Channel string `json:"channel"`
+				} `json:"data"`
+			}
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+
+			var resp string
+			switch {
+			case req.Data.Channel == "live_trades_xrpusd":
+				// never confirmed
+				continue
+			case req.Data.Channel == "live_trades_ethusd":
+				resp = `{"event":"bts:error","channel":"live_trades_ethusd","data":{"code":null,"message":"Bad subscription string."}}`
+			case req.Data.Channel == "live_trades_ltcusd":
+				// errors often do not state the channel
+				resp = `{"event":"bts:error","channel":"","data":{"code":null,"message":"Incorrect JSON format."}}`
+			case req.Event == "bts:subscribe":
+				resp = `{"event":"bts:subscription_succeeded","channel":"` + req.Data.Channel + `","data":{}}`
+			default:
+				resp = `{"event":"bts:unsubscription_succeeded","channel":"` + req.Data.Channel + `","data":{}}`
+			}
+
+			if err := c.WriteMessage(websocket.TextMessage, []byte(resp)); err != nil {
+				return
+			}
+		}
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(
+		bitstamp.SetWSAddressOption(address),
+		bitstamp.SubscriptionAckOption(),
+	)
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := ws.Consume(ctx)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+	go func() {
+		for range messages {
+		}
+	}()
+
+	state := func(c bitstamp.Channel) (bitstamp.SubscriptionState, bool) {
+		for _, s := range ws.GetSubscriptions() {
+			if s.Channel == c {
+				return s.State, true
+			}
+		}
+		return 0, false
+	}
+
+	if err := ws.SubscribeToChannels(ctx, bitstamp.LiveTradesBTCUSDChannel); err != nil {
+		t.Fatalf("Expected subscription to succeed got %s", err)
+	}
+	if s, _ := state(bitstamp.LiveTradesBTCUSDChannel); s != bitstamp.SubscriptionActive {
+		t.Fatalf("Expected state %s got %s", bitstamp.SubscriptionActive, s)
+	}
+
+	err = ws.SubscribeToChannels(ctx, bitstamp.LiveTradesETHUSDChannel)
+	var subErr bitstamp.SubscriptionError
+	if !errors.Is(err, bitstamp.ErrSubscriptionFailed) || !errors.As(err, &subErr) || subErr.Message != "Bad subscription string." {
+		t.Fatalf("Expected subscription error got %v", err)
+	}
+	if s, _ := state(bitstamp.LiveTradesETHUSDChannel); s != bitstamp.SubscriptionFailed {
+		t.Fatalf("Expected state %s got %s", bitstamp.SubscriptionFailed, s)
+	}
+
+	err = ws.SubscribeToChannels(ctx, bitstamp.LiveTradesLTCUSDChannel)
+	if !errors.As(err, &subErr) || subErr.Channel != "live_trades_ltcusd" || subErr.Message != "Incorrect JSON format." {
+		t.Fatalf("Expected subscription error for live_trades_ltcusd got %v", err)
+	}
+	if s, _ := state(bitstamp.LiveTradesLTCUSDChannel); s != bitstamp.SubscriptionFailed {
+		t.Fatalf("Expected state %s got %s", bitstamp.SubscriptionFailed, s)
+	}
+
+	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer timeoutCancel()
+	if err := ws.SubscribeToChannels(timeoutCtx, bitstamp.LiveTradesXRPUSDChannel); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected error %s got %v", context.DeadlineExceeded, err)
+	}
+	if s, _ := state(bitstamp.LiveTradesXRPUSDChannel); s != bitstamp.SubscriptionPending {
+		t.Fatalf("Expected state %s got %s", bitstamp.SubscriptionPending, s)
+	}
+
+	if err := ws.UnSubscribeFromChannels(ctx, bitstamp.LiveTradesBTCUSDChannel); err != nil {
+		t.Fatalf("Expected unsubscription to succeed got %s", err)
+	}
+	if _, ok := state(bitstamp.LiveTradesBTCUSDChannel); ok {
+		t.Fatal("Expected channel to not be tracked after unsubscribing")
+	}
+}
+
+func TestWebsocketAPI_Consume_SubscriptionErrorWithoutChannel(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		if !expectSubscribe(t, c, "live_trades_ltcusd") || !expectSubscribe(t, c, "live_trades_btcusd") {
+			return
+		}
+
+		// errors often do not state the channel, the first one refers to the oldest pending request
+		responses := []string{
+			`{"event":"bts:error","channel":"","data":{"code":null,"message":"Incorrect JSON format."}}`,
+			`{"event":"bts:subscription_succeeded","channel":"live_trades_btcusd","data":{}}`,
+			testTradeMessage,
+		}
+		for i := range responses {
+			if err := c.WriteMessage(websocket.TextMessage, []byte(responses[i])); err != nil {
+				return
+			}
+		}
+		_, _, _ = c.ReadMessage()
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(bitstamp.SetWSAddressOption(address))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := ws.Consume(ctx, bitstamp.LiveTradesLTCUSDChannel, bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	// responses are processed before the trade is delivered
+	for {
+		if _, ok := nextMessage(t, messages).Message.(bitstamp.LiveTickerChannel); ok {
+			break
+		}
+	}
+
+	expected := map[bitstamp.Channel]bitstamp.SubscriptionState{
+		bitstamp.LiveTradesLTCUSDChannel: bitstamp.SubscriptionFailed,
+		bitstamp.LiveTradesBTCUSDChannel: bitstamp.SubscriptionActive,
+	}
+	for _, s := range ws.GetSubscriptions() {
+		if s.State != expected[s.Channel] {
+			t.Fatalf("Expected %s state to be %s got %s", s.Channel, expected[s.Channel], s.State)
+		}
+		if s.State == bitstamp.SubscriptionFailed && !errors.Is(s.Err, bitstamp.ErrSubscriptionFailed) {
+			t.Fatalf("Expected %s error to be %s got %v", s.Channel, bitstamp.ErrSubscriptionFailed, s.Err)
+		}
+	}
+}
+
+func TestWebsocketAPI_Consume_AlreadySubscribed(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(bitstamp.SetWSAddressOption(address))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := ws.Consume(ctx, bitstamp.LiveTradesBTCUSDChannel); err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	if _, err := ws.Consume(ctx, bitstamp.LiveTradesETHUSDChannel); !errors.Is(err, bitstamp.ErrAlreadySubscribed) {
+		t.Fatalf("Expected error %s got %v", bitstamp.ErrAlreadySubscribed, err)
+	}
+}
+
+func TestWebsocketAPI_Consume_SubscribeRejected(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		if !expectSubscribe(t, c, "live_trades_btcusd") {
+			return
+		}
+		_ = c.WriteMessage(websocket.TextMessage, []byte(testTradeMessage))
+		_, _, _ = c.ReadMessage()
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(bitstamp.SetWSAddressOption(address))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// private channels are rejected without PrivateChannelsOption
+	if _, err := ws.Consume(ctx, bitstamp.MyOrdersBTCUSDPrivateChannel); !errors.Is(err, bitstamp.ErrPrivateChannelsNotEnabled) {
+		t.Fatalf("Expected error %s got %v", bitstamp.ErrPrivateChannelsNotEnabled, err)
+	}
+
+	messages, err := ws.Consume(ctx, bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("Expected consume to succeed after a rejected subscription got %s", err)
+	}
+
+	if m := nextMessage(t, messages); m.Error != nil {
+		t.Fatalf("Expected trade message got %+v", m)
+	}
+}
+
+func TestWebsocketAPI_SubscribeToChannels_Concurrent(t *testing.T) {
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		for {
+			_, msg, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			// echo a trade for every subscription so that reads and writes happen at the same time
+			if strings.Contains(string(msg), "bts:subscribe") {
+				if err := c.WriteMessage(websocket.TextMessage, []byte(testTradeMessage)); err != nil {
+					return
+				}
+			}
+		}
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(
+		bitstamp.SetWSAddressOption(address),
+		bitstamp.HeartbeatOption(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := ws.Consume(ctx)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	channels := bitstamp.GetLiveTradeChannels()
+	if len(channels) > 50 {
+		channels = channels[:50]
+	}
+
+	errs := make(chan error, len(channels))
+	for i := range channels {
+		go func(c bitstamp.Channel) {
+			if err := ws.SubscribeToChannels(ctx, c); err != nil {
+				errs <- err
+				return
+			}
+			errs <- ws.UnSubscribeFromChannels(ctx, c)
+		}(channels[i])
+	}
+
+	for done := 0; done < len(channels); {
+		select {
+		case err := <-errs:
+			if err != nil {
+				t.Fatalf("Failed to subscribe, %s", err)
+			}
+			done++
+		case <-messages:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for subscriptions")
+		}
+	}
+
+	if subs := ws.GetSubscriptions(); len(subs) != 0 {
+		t.Fatalf("Expected no subscriptions got %d", len(subs))
+	}
+
+	if err := ws.Close(); err != nil {
+		t.Fatalf("Failed to close connection, %s", err)
+	}
+	if err := ws.SubscribeToChannels(context.Background(), channels[0]); !errors.Is(err, bitstamp.ErrWriteMessage) {
+		t.Fatalf("Expected error %s got %v", bitstamp.ErrWriteMessage, err)
+	}
+}
+
+func TestWebsocketAPI_Serve(t *testing.T) {
+	subscribed := make(chan []string, 1)
+
+	address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+		var channels []string
+		for i := 0; i < 4; i++ {
+			var req struct {
+				Data struct {
+					Channel string `json:"channel"`
+				} `json:"data"`
+			}
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			channels = append(channels, req.Data.Channel)
+		}
+		subscribed <- channels
+
+		for _, m := range []string{
+			testTradeMessage,
+			strings.ReplaceAll(testTradeMessage, "live_trades_btcusd", "live_trades_ethusd"),
+			`{"data":{"timestamp":"1637511612","microtimestamp":"1637511612084301","bids":[["52261.99","0.1"]],` +
+				`"asks":[["52270.00","0.05"]]},"channel":"order_book_btcusd","event":"data"}`,
+			`{"event":"bts:subscription_succeeded","channel":"live_trades_btcusd","data":{}}`,
+		} {
+			if err := c.WriteMessage(websocket.TextMessage, []byte(m)); err != nil {
+				return
+			}
+		}
+	})
+
+	ws, err := bitstamp.NewWebsocketAPI(bitstamp.SetWSAddressOption(address))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	var (
+		btcTrades, allTrades, books, raw int
+		bestBid                          string
+	)
+	ws.OnTrade(func(m bitstamp.LiveTickerChannel) { btcTrades++ }, bitstamp.BTCUSD)
+	ws.OnTrade(func(m bitstamp.LiveTickerChannel) { allTrades++ })
+	ws.OnOrderBook(func(m bitstamp.LiveOrderBookChannel) {
+		books++
+		bestBid = m.Data.Bids[0].Price.String()
+	}, bitstamp.BTCUSD, bitstamp.ETHUSD)
+	ws.OnDiffOrderBook(func(m bitstamp.LiveFullOrderBook) {
+		t.Error("Unexpected diff order book message")
+	})
+	ws.OnRaw(func(m bitstamp.WebsocketMessage) { raw++ })
+
+	err = ws.Serve(context.Background(), bitstamp.LiveTradesETHUSDChannel)
+	if !errors.Is(err, bitstamp.ErrReadMessage) {
+		t.Fatalf("Expected error %s got %v", bitstamp.ErrReadMessage, err)
+	}
+
+	channels := <-subscribed
+	sort.Strings(channels)
+	expected := []string{"live_trades_btcusd", "live_trades_ethusd", "order_book_btcusd", "order_book_ethusd"}
+	if len(channels) != len(expected) {
+		t.Fatalf("Expected subscriptions %v got %v", expected, channels)
+	}
+	for i := range expected {
+		if channels[i] != expected[i] {
+			t.Fatalf("Expected subscriptions %v got %v", expected, channels)
+		}
+	}
+
+	// raw handler receives every message plus the error that closed the stream
+	if btcTrades != 1 || allTrades != 2 || books != 1 || bestBid != "52261.99" || raw != 5 {
+		t.Fatalf("Unexpected dispatch, btc trades %d all trades %d books %d best bid %s raw %d",
+			btcTrades, allTrades, books, bestBid, raw)
+	}
+}
+
+func TestWebsocketAPI_Consume_Buffer(t *testing.T) {
+	trade := func(id int) string {
+		return strings.Replace(testTradeMessage, `"id":1,`, fmt.Sprintf(`"id":%d,`, id), 1)
+	}
+	book := func(ts int) string {
+		return fmt.Sprintf(`{"data":{"timestamp":"1637511612","microtimestamp":"%d","bids":[],"asks":[]},`+
+			`"channel":"order_book_btcusd","event":"data"}`, ts)
+	}
+
+	testCases := []struct {
+		description       string
+		policy            bitstamp.OverflowPolicy
+		size              int
+		input             []string
+		expected          []string
+		expectedDropped   uint64
+		expectedCoalesced uint64
+	}{
+		{
+			description:     "Should drop newest messages",
+			policy:          bitstamp.OverflowDropNewest,
+			size:            2,
+			input:           []string{trade(1), trade(2), trade(3), trade(4), trade(5)},
+			expected:        []string{"trade 1", "trade 2"},
+			expectedDropped: 3,
+		},
+		{
+			description:     "Should drop oldest messages",
+			policy:          bitstamp.OverflowDropOldest,
+			size:            2,
+			input:           []string{trade(1), trade(2), trade(3), trade(4), trade(5)},
+			expected:        []string{"trade 4", "trade 5"},
+			expectedDropped: 3,
+		},
+		{
+			description:       "Should coalesce order book snapshots",
+			policy:            bitstamp.OverflowCoalesce,
+			size:              10,
+			input:             []string{book(1), trade(1), book(2), book(3)},
+			expected:          []string{"trade 1", "book 3"},
+			expectedCoalesced: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			address := newWebsocketServer(t, func(n int, c *websocket.Conn) {
+				if !expectSubscribe(t, c, "live_trades_btcusd") {
+					return
+				}
+				for i := range tc.input {
+					_ = c.WriteMessage(websocket.TextMessage, []byte(tc.input[i]))
+				}
+				_, _, _ = c.ReadMessage()
+			})
+
+			ws, err := bitstamp.NewWebsocketAPI(
+				bitstamp.SetWSAddressOption(address),
+				bitstamp.BufferOption(tc.size, tc.policy),
+			)
+			if err != nil {
+				t.Fatalf("failed to initialize websocket client, %s", err)
+			}
+			defer ws.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			messages, err := ws.Consume(ctx, bitstamp.LiveTradesBTCUSDChannel)
+			if err != nil {
+				t.Fatalf("failed to consume, %s", err)
+			}
+
+			// wait until all messages are buffered
+			deadline := time.Now().Add(5 * time.Second)
+			for ws.DroppedMessages() != tc.expectedDropped || ws.CoalescedMessages() != tc.expectedCoalesced {
+				if time.Now().After(deadline) {
+					t.Fatalf("Expected %d dropped and %d coalesced messages got %d and %d", tc.expectedDropped,
+						tc.expectedCoalesced, ws.DroppedMessages(), ws.CoalescedMessages())
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			for i := range tc.expected {
+				var result string
+				switch v := nextMessage(t, messages).Message.(type) {
+				case bitstamp.LiveTickerChannel:
+					result = fmt.Sprintf("trade %d", v.Data.ID)
+				case bitstamp.LiveOrderBookChannel:
+					result = fmt.Sprintf("book %s", v.Data.Microtimestamp.Raw)
+				}
+
+				if result != tc.expected[i] {
+					t.Fatalf("Expected %s got %s", tc.expected[i], result)
+				}
+			}
+		})
+	}
+}
+
+func TestWebsocketAPI_Connect(t *testing.T) {
+	var (
+		upgrader    = websocket.Upgrader{EnableCompression: true}
+		connections int32
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&connections, 1)
+
+		if r.Header.Get("User-Agent") != "bitstamp-test" {
+			t.Errorf("Expected User-Agent header bitstamp-test got %s", r.Header.Get("User-Agent"))
+		}
+		if !strings.Contains(r.Header.Get("Sec-Websocket-Extensions"), "permessage-deflate") {
+			t.Errorf("Expected compression to be negotiated got %s", r.Header.Get("Sec-Websocket-Extensions"))
+		}
+
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection, %s", err)
+			return
+		}
+		defer c.Close()
+
+		if !expectSubscribe(t, c, "live_trades_btcusd") {
+			return
+		}
+		_ = c.WriteMessage(websocket.TextMessage, []byte(testTradeMessage))
+		_, _, _ = c.ReadMessage()
+	}))
+	defer ts.Close()
+
+	var dials int32
+	dialer := websocket.Dialer{
+		NetDialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			atomic.AddInt32(&dials, 1)
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}
+
+	ws, err := bitstamp.NewWebsocketAPI(
+		bitstamp.SetWSAddressOption("ws"+strings.TrimPrefix(ts.URL, "http")),
+		bitstamp.DialerOption(&dialer),
+		bitstamp.HeaderOption(http.Header{"User-Agent": []string{"bitstamp-test"}}),
+		bitstamp.CompressionOption(),
+		bitstamp.HandshakeTimeoutOption(time.Second),
+		bitstamp.DeferConnectOption(),
+	)
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client, %s", err)
+	}
+	defer ws.Close()
+
+	if n := atomic.LoadInt32(&connections); n != 0 {
+		t.Fatalf("Expected no connection before Connect got %d", n)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := ws.SubscribeToChannels(ctx, bitstamp.LiveTradesBTCUSDChannel); !errors.Is(err, bitstamp.ErrWriteMessage) {
+		t.Fatalf("Expected %s got %v", bitstamp.ErrWriteMessage, err)
+	}
+
+	if err := ws.Connect(ctx); err != nil {
+		t.Fatalf("failed to connect, %s", err)
+	}
+
+	// already connected
+	if err := ws.Connect(ctx); err != nil {
+		t.Fatalf("failed to connect, %s", err)
+	}
+
+	messages, err := ws.Consume(ctx, bitstamp.LiveTradesBTCUSDChannel)
+	if err != nil {
+		t.Fatalf("failed to consume, %s", err)
+	}
+
+	if m := nextMessage(t, messages); m.Error != nil {
+		t.Fatalf("Expected trade message got %+v", m)
+	}
+
+	if n := atomic.LoadInt32(&dials); n != 1 {
+		t.Fatalf("Expected 1 dial using custom dialer got %d", n)
+	}
+}